@@ -0,0 +1,162 @@
+package astiav
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AudioFrameRechunker buffers audio frames of arbitrary sizes and outputs frames containing exactly
+// CodecContext.FrameSize() samples, which is what most fixed frame size encoders (AAC, Opus, etc.) expect.
+//
+// Output frames have continuous pts expressed in the codec context time base.
+//
+// It mirrors the CodecContext send/receive API: send frames with SendFrame (nil meaning EOF) and receive
+// frames with ReceiveFrame until it returns ErrEagain (more data is needed) or ErrEof (everything has been
+// flushed).
+type AudioFrameRechunker struct {
+	cc           *CodecContext
+	eof          bool
+	f            *AudioFifo
+	nbSamples    int64
+	padLastFrame bool
+	startPts     int64
+	timeBase     Rational
+}
+
+// NewAudioFrameRechunker creates a rechunker for the provided encoder codec context. timeBase is the time base
+// in which pts of frames sent to the rechunker are expressed.
+func NewAudioFrameRechunker(cc *CodecContext, timeBase Rational) (*AudioFrameRechunker, error) {
+	if cc == nil {
+		return nil, errors.New("astiav: codec context must not be nil")
+	}
+	if cc.FrameSize() <= 0 {
+		return nil, fmt.Errorf("astiav: invalid frame size %d <= 0", cc.FrameSize())
+	}
+	f := AllocAudioFifo(cc.SampleFormat(), cc.ChannelLayout().Channels(), cc.FrameSize())
+	if f == nil {
+		return nil, errors.New("astiav: allocating audio fifo failed")
+	}
+	return &AudioFrameRechunker{
+		cc:       cc,
+		f:        f,
+		startPts: NoPtsValue,
+		timeBase: timeBase,
+	}, nil
+}
+
+func (r *AudioFrameRechunker) Free() {
+	if r.f != nil {
+		r.f.Free()
+		r.f = nil
+	}
+}
+
+// PadLastFrame indicates whether the last frame, if shorter than the frame size, is padded with silence
+func (r *AudioFrameRechunker) PadLastFrame() bool {
+	return r.padLastFrame
+}
+
+// SetPadLastFrame sets whether the last frame, if shorter than the frame size, is padded with silence. By default
+// the last frame is output with less samples than the frame size.
+func (r *AudioFrameRechunker) SetPadLastFrame(b bool) {
+	r.padLastFrame = b
+}
+
+// Size returns the number of samples currently buffered
+func (r *AudioFrameRechunker) Size() int {
+	return r.f.Size()
+}
+
+// SendFrame buffers the frame's samples. Sending a nil frame signals EOF and allows the last buffered samples
+// to be received.
+func (r *AudioFrameRechunker) SendFrame(f *Frame) error {
+	// EOF has already been signaled
+	if r.eof {
+		return ErrEof
+	}
+
+	// EOF
+	if f == nil {
+		r.eof = true
+		return nil
+	}
+
+	// Check frame
+	if f.SampleFormat() != r.cc.SampleFormat() {
+		return fmt.Errorf("astiav: frame sample format %s doesn't match codec context sample format %s", f.SampleFormat(), r.cc.SampleFormat())
+	}
+	if f.ChannelLayout().Channels() != r.cc.ChannelLayout().Channels() {
+		return fmt.Errorf("astiav: frame channels %d don't match codec context channels %d", f.ChannelLayout().Channels(), r.cc.ChannelLayout().Channels())
+	}
+	if f.SampleRate() != r.cc.SampleRate() {
+		return fmt.Errorf("astiav: frame sample rate %d doesn't match codec context sample rate %d", f.SampleRate(), r.cc.SampleRate())
+	}
+
+	// Nothing to buffer
+	if f.NbSamples() == 0 {
+		return nil
+	}
+
+	// Store start pts
+	if r.startPts == NoPtsValue {
+		if f.Pts() != NoPtsValue {
+			r.startPts = RescaleQ(f.Pts(), r.timeBase, r.cc.TimeBase())
+		} else {
+			r.startPts = 0
+		}
+	}
+
+	// Write
+	if _, err := r.f.Write(f); err != nil {
+		return fmt.Errorf("astiav: writing to audio fifo failed: %w", err)
+	}
+	return nil
+}
+
+// ReceiveFrame unreferences the frame and fills it with the next chunk of samples. It returns ErrEagain if
+// more frames need to be sent, and ErrEof once EOF has been signaled and all buffered samples have been received.
+func (r *AudioFrameRechunker) ReceiveFrame(f *Frame) error {
+	// Get number of samples to read
+	frameSize := r.cc.FrameSize()
+	size := r.f.Size()
+	if size == 0 && r.eof {
+		return ErrEof
+	} else if size < frameSize && !r.eof {
+		return ErrEagain
+	}
+
+	// Make sure to unreference the frame
+	f.Unref()
+
+	// Allocate buffer
+	f.SetChannelLayout(r.cc.ChannelLayout())
+	f.SetNbSamples(frameSize)
+	f.SetSampleFormat(r.cc.SampleFormat())
+	f.SetSampleRate(r.cc.SampleRate())
+	if err := f.AllocBuffer(0); err != nil {
+		return fmt.Errorf("astiav: allocating buffer failed: %w", err)
+	}
+
+	// Last frame needs to be padded
+	if size < frameSize && r.padLastFrame {
+		if err := f.SamplesFillSilence(); err != nil {
+			return fmt.Errorf("astiav: filling silence failed: %w", err)
+		}
+	}
+
+	// Read
+	n, err := r.f.Read(f)
+	if err != nil {
+		return fmt.Errorf("astiav: reading from audio fifo failed: %w", err)
+	}
+
+	// Last frame is shorter
+	if n < frameSize && !r.padLastFrame {
+		f.SetNbSamples(n)
+	}
+
+	// Update pts
+	f.SetPts(r.startPts + RescaleQ(r.nbSamples, NewRational(1, r.cc.SampleRate()), r.cc.TimeBase()))
+	r.nbSamples += int64(n)
+	return nil
+}
@@ -0,0 +1,75 @@
+package astiav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAudioFrameRechunker(t *testing.T) {
+	c := FindEncoder(CodecIDAac)
+	require.NotNil(t, c)
+	cc := AllocCodecContext(c)
+	require.NotNil(t, cc)
+	defer cc.Free()
+	cc.SetChannelLayout(ChannelLayoutStereo)
+	cc.SetSampleFormat(SampleFormatFltp)
+	cc.SetSampleRate(48000)
+	cc.SetTimeBase(NewRational(1, 48000))
+	require.NoError(t, cc.Open(c, nil))
+	require.Equal(t, 1024, cc.FrameSize())
+
+	r, err := NewAudioFrameRechunker(cc, NewRational(1, 1000))
+	require.NoError(t, err)
+	defer r.Free()
+	require.False(t, r.PadLastFrame())
+
+	wf := AllocFrame()
+	defer wf.Free()
+	wf.SetNbSamples(1500)
+	wf.SetChannelLayout(ChannelLayoutStereo)
+	wf.SetSampleFormat(SampleFormatFltp)
+	wf.SetSampleRate(48000)
+	wf.SetPts(10)
+	require.NoError(t, wf.AllocBuffer(0))
+
+	rf := AllocFrame()
+	defer rf.Free()
+
+	require.ErrorIs(t, r.ReceiveFrame(rf), ErrEagain)
+	require.NoError(t, r.SendFrame(wf))
+	require.Equal(t, 1500, r.Size())
+	require.NoError(t, r.ReceiveFrame(rf))
+	require.Equal(t, 1024, rf.NbSamples())
+	require.Equal(t, int64(480), rf.Pts())
+	require.ErrorIs(t, r.ReceiveFrame(rf), ErrEagain)
+	require.NoError(t, r.SendFrame(wf))
+	require.NoError(t, r.ReceiveFrame(rf))
+	require.Equal(t, 1024, rf.NbSamples())
+	require.Equal(t, int64(1504), rf.Pts())
+	require.ErrorIs(t, r.ReceiveFrame(rf), ErrEagain)
+	require.NoError(t, r.SendFrame(nil))
+	require.ErrorIs(t, r.SendFrame(wf), ErrEof)
+	require.NoError(t, r.ReceiveFrame(rf))
+	require.Equal(t, 952, rf.NbSamples())
+	require.Equal(t, int64(2528), rf.Pts())
+	require.ErrorIs(t, r.ReceiveFrame(rf), ErrEof)
+
+	r2, err := NewAudioFrameRechunker(cc, NewRational(1, 48000))
+	require.NoError(t, err)
+	defer r2.Free()
+	r2.SetPadLastFrame(true)
+	require.True(t, r2.PadLastFrame())
+	wf.SetSampleRate(44100)
+	require.Error(t, r2.SendFrame(wf))
+	wf.SetSampleRate(48000)
+	wf.SetPts(NoPtsValue)
+	require.NoError(t, r2.SendFrame(wf))
+	require.NoError(t, r2.SendFrame(nil))
+	require.NoError(t, r2.ReceiveFrame(rf))
+	require.Equal(t, int64(0), rf.Pts())
+	require.NoError(t, r2.ReceiveFrame(rf))
+	require.Equal(t, 1024, rf.NbSamples())
+	require.Equal(t, int64(1024), rf.Pts())
+	require.ErrorIs(t, r2.ReceiveFrame(rf), ErrEof)
+}
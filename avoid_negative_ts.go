@@ -0,0 +1,14 @@
+package astiav
+
+//#include <libavformat/avformat.h>
+import "C"
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html
+type AvoidNegativeTs int
+
+const (
+	AvoidNegativeTsAuto            = AvoidNegativeTs(C.AVFMT_AVOID_NEG_TS_AUTO)
+	AvoidNegativeTsDisabled        = AvoidNegativeTs(C.AVFMT_AVOID_NEG_TS_DISABLED)
+	AvoidNegativeTsMakeNonNegative = AvoidNegativeTs(C.AVFMT_AVOID_NEG_TS_MAKE_NON_NEGATIVE)
+	AvoidNegativeTsMakeZero        = AvoidNegativeTs(C.AVFMT_AVOID_NEG_TS_MAKE_ZERO)
+)
@@ -38,6 +38,21 @@ func (c *Codec) ID() CodecID {
 	return CodecID(c.c.id)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVCodec.html#af51f7ff3dac8b730f46b9713e49a2518
+func (c *Codec) Capabilities() CodecCapabilityFlags {
+	return CodecCapabilityFlags(c.c.capabilities)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodec.html#ac832350f2bac582fe3d174e2e3b1eb5e
+func (c *Codec) Profiles() []CodecProfile {
+	return newCodecProfilesFromC(c.c.profiles)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodec.html#ad2f26d22c4dc49710bebc0fceda4d125
+func (c *Codec) WrapperName() string {
+	return C.GoString(c.c.wrapper_name)
+}
+
 // https://ffmpeg.org/doxygen/8.0/group__lavc__core.html#ga6dc18eef1afca3610644a52565cf8a31
 func (c *Codec) IsDecoder() bool {
 	return int(C.av_codec_is_decoder(c.c)) != 0
@@ -0,0 +1,27 @@
+package astiav
+
+//#include <libavcodec/avcodec.h>
+import "C"
+
+// https://ffmpeg.org/doxygen/8.0/group__lavc__core.html
+type CodecCapabilityFlag int64
+
+const (
+	CodecCapabilityFlagAvoidProbing           = CodecCapabilityFlag(C.AV_CODEC_CAP_AVOID_PROBING)
+	CodecCapabilityFlagChannelConf            = CodecCapabilityFlag(C.AV_CODEC_CAP_CHANNEL_CONF)
+	CodecCapabilityFlagDelay                  = CodecCapabilityFlag(C.AV_CODEC_CAP_DELAY)
+	CodecCapabilityFlagDr1                    = CodecCapabilityFlag(C.AV_CODEC_CAP_DR1)
+	CodecCapabilityFlagDrawHorizBand          = CodecCapabilityFlag(C.AV_CODEC_CAP_DRAW_HORIZ_BAND)
+	CodecCapabilityFlagEncoderFlush           = CodecCapabilityFlag(C.AV_CODEC_CAP_ENCODER_FLUSH)
+	CodecCapabilityFlagEncoderReconFrame      = CodecCapabilityFlag(C.AV_CODEC_CAP_ENCODER_RECON_FRAME)
+	CodecCapabilityFlagEncoderReorderedOpaque = CodecCapabilityFlag(C.AV_CODEC_CAP_ENCODER_REORDERED_OPAQUE)
+	CodecCapabilityFlagExperimental           = CodecCapabilityFlag(C.AV_CODEC_CAP_EXPERIMENTAL)
+	CodecCapabilityFlagFrameThreads           = CodecCapabilityFlag(C.AV_CODEC_CAP_FRAME_THREADS)
+	CodecCapabilityFlagHardware               = CodecCapabilityFlag(C.AV_CODEC_CAP_HARDWARE)
+	CodecCapabilityFlagHybrid                 = CodecCapabilityFlag(C.AV_CODEC_CAP_HYBRID)
+	CodecCapabilityFlagOtherThreads           = CodecCapabilityFlag(C.AV_CODEC_CAP_OTHER_THREADS)
+	CodecCapabilityFlagParamChange            = CodecCapabilityFlag(C.AV_CODEC_CAP_PARAM_CHANGE)
+	CodecCapabilityFlagSliceThreads           = CodecCapabilityFlag(C.AV_CODEC_CAP_SLICE_THREADS)
+	CodecCapabilityFlagSmallLastFrame         = CodecCapabilityFlag(C.AV_CODEC_CAP_SMALL_LAST_FRAME)
+	CodecCapabilityFlagVariableFrameSize      = CodecCapabilityFlag(C.AV_CODEC_CAP_VARIABLE_FRAME_SIZE)
+)
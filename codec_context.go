@@ -1,8 +1,12 @@
 package astiav
 
 //#include "codec_context.h"
+//#include <stdlib.h>
 import "C"
 import (
+	"errors"
+	"fmt"
+	"strconv"
 	"sync"
 	"unsafe"
 )
@@ -41,6 +45,10 @@ func (cc *CodecContext) Free() {
 		if cc.c.hw_frames_ctx != nil {
 			C.av_buffer_unref(&cc.c.hw_frames_ctx)
 		}
+		// stats_in is allocated by the user and not freed by libavcodec
+		if cc.c.stats_in != nil {
+			C.av_freep(unsafe.Pointer(&cc.c.stats_in))
+		}
 		// Make sure to clone the classer before freeing the object since
 		// the C free method may reset the pointer
 		c := newClonedClasser(cc)
@@ -95,11 +103,21 @@ func (cc *CodecContext) Class() *Class {
 	return newClassFromC(unsafe.Pointer(cc.c))
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a6e606effa68724cae2ef5cc05f7fd9cb
+func (cc *CodecContext) Codec() *Codec {
+	return newCodecFromC(cc.c.codec)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#adc5f65d6099fd8339c1580c091777223
 func (cc *CodecContext) CodecID() CodecID {
 	return CodecID(cc.c.codec_id)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a0d9375d5c81895b9048644e547b33d32
+func (cc *CodecContext) CodedSideData() *PacketSideData {
+	return newPacketSideDataFromC(&cc.c.coded_side_data, &cc.c.nb_coded_side_data)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a3a41b3e5bde23b877799f6e72dac8ef3
 func (cc *CodecContext) ColorPrimaries() ColorPrimaries {
 	return ColorPrimaries(cc.c.color_primaries)
@@ -298,6 +316,40 @@ func (cc *CodecContext) SetSampleRate(sampleRate int) {
 	cc.c.sample_rate = C.int(sampleRate)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a4ee62148c5519301149f75e7296e84e1
+func (cc *CodecContext) StatsIn() string {
+	if cc.c.stats_in == nil {
+		return ""
+	}
+	return C.GoString(cc.c.stats_in)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a4ee62148c5519301149f75e7296e84e1
+func (cc *CodecContext) SetStatsIn(s string) error {
+	if cc.c.stats_in != nil {
+		C.av_freep(unsafe.Pointer(&cc.c.stats_in))
+	}
+	if s == "" {
+		return nil
+	}
+	sc := C.CString(s)
+	defer C.free(unsafe.Pointer(sc))
+	if cc.c.stats_in = C.av_strdup(sc); cc.c.stats_in == nil {
+		return errors.New("astiav: duplicating stats failed")
+	}
+	return nil
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a0f5bec27a856369ac81ccc9784f52e86
+//
+// Must be read after each ReceivePacket() call when CodecContextFlagPass1 is set
+func (cc *CodecContext) StatsOut() string {
+	if cc.c.stats_out == nil {
+		return ""
+	}
+	return C.GoString(cc.c.stats_out)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a3090804569341ca235e3adbdc03318d2
 func (cc *CodecContext) StrictStdCompliance() StrictStdCompliance {
 	return StrictStdCompliance(cc.c.strict_std_compliance)
@@ -357,6 +409,112 @@ func (cc *CodecContext) Open(c *Codec, d *Dictionary) error {
 	return newError(C.avcodec_open2(cc.c, c.c, dc))
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__lavc__core.html#ga906dda732e79eac12067c6d7ea19b630
+func (cc *CodecContext) IsOpen() bool {
+	return C.avcodec_is_open(cc.c) > 0
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavc__misc.html#gaf60b0e076f822abcb2700eb601d352a6
+//
+// Codec context must be open. Encoders can only be flushed if they have the
+// CodecCapabilityFlagEncoderFlush capability
+func (cc *CodecContext) FlushBuffers() error {
+	if !cc.IsOpen() {
+		return errors.New("astiav: codec context is not open")
+	}
+	if c := cc.Codec(); c != nil && c.IsEncoder() && !c.Capabilities().Has(CodecCapabilityFlagEncoderFlush) {
+		return fmt.Errorf("astiav: encoder %s doesn't support flushing", c.Name())
+	}
+	C.avcodec_flush_buffers(cc.c)
+	return nil
+}
+
+type CodecContextReconfigureResult struct {
+	BitRate    bool
+	BufferSize bool
+	MaxRate    bool
+}
+
+// Encoders, indexed by name, whose FFmpeg wrapper reads rate control fields again on every frame.
+// Funcs return which fields are applied by the encoder in its current configuration.
+var codecContextReconfigurableEncoders = map[string]func(cc *CodecContext) (CodecContextReconfigureResult, error){
+	"libx264":    codecContextLibx264ReconfigurableFields,
+	"libx264rgb": codecContextLibx264ReconfigurableFields,
+}
+
+// libx264 always applies max rate and buffer size changes, but only applies bit rate changes when
+// rate control is in ABR mode, which is the case when a bit rate has been provided and neither the
+// crf nor the qp private options have been set
+func codecContextLibx264ReconfigurableFields(cc *CodecContext) (r CodecContextReconfigureResult, err error) {
+	r.BufferSize = true
+	r.MaxRate = true
+	if cc.BitRate() <= 0 {
+		return
+	}
+	pd := cc.PrivateData()
+	if pd == nil {
+		return
+	}
+	os := pd.Options()
+	for _, name := range []string{"crf", "qp"} {
+		var v string
+		if v, err = os.Get(name, 0); err != nil {
+			err = fmt.Errorf("astiav: getting %s option failed: %w", name, err)
+			return
+		}
+		var f float64
+		if f, err = strconv.ParseFloat(v, 64); err != nil {
+			err = fmt.Errorf("astiav: parsing %s option failed: %w", name, err)
+			return
+		}
+		if f >= 0 {
+			return
+		}
+	}
+	r.BitRate = true
+	return
+}
+
+// Reconfigure updates the bit rate, max rate and buffer size. Values <= 0 are left untouched.
+//
+// Before the codec context is opened, every value is updated. Once it is open, FFmpeg's generic rate
+// control options don't have the OptionFlagRuntimeParam flag, therefore values are only updated for
+// encoders whose wrapper reads them again on every frame:
+//
+//   - libx264 and libx264rgb: max rate and buffer size are always applied whereas bit rate is only
+//     applied in ABR mode (i.e. a bit rate was set when opening and neither crf nor qp are set)
+//
+// The result reports which values have been updated.
+func (cc *CodecContext) Reconfigure(bitRate, maxRate int64, bufferSize int) (r CodecContextReconfigureResult, err error) {
+	// Get supported values
+	supported := CodecContextReconfigureResult{BitRate: true, BufferSize: true, MaxRate: true}
+	if cc.IsOpen() {
+		supported = CodecContextReconfigureResult{}
+		if c := cc.Codec(); c != nil {
+			if fn, ok := codecContextReconfigurableEncoders[c.Name()]; ok {
+				if supported, err = fn(cc); err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	// Update values
+	if bitRate > 0 && supported.BitRate {
+		cc.c.bit_rate = C.int64_t(bitRate)
+		r.BitRate = true
+	}
+	if bufferSize > 0 && supported.BufferSize {
+		cc.c.rc_buffer_size = C.int(bufferSize)
+		r.BufferSize = true
+	}
+	if maxRate > 0 && supported.MaxRate {
+		cc.c.rc_max_rate = C.int64_t(maxRate)
+		r.MaxRate = true
+	}
+	return
+}
+
 // https://ffmpeg.org/doxygen/8.0/group__lavc__decoding.html#ga5b8eff59cf259747cf0b31563e38ded6
 func (cc *CodecContext) ReceivePacket(p *Packet) error {
 	var pc *C.AVPacket
@@ -393,6 +551,58 @@ func (cc *CodecContext) SendFrame(f *Frame) error {
 	return newError(C.avcodec_send_frame(cc.c, fc))
 }
 
+// Drain enters draining mode by sending a nil packet and sends every remaining frame to fn until the
+// decoder is fully drained. Frames are unreferenced once fn returns. ErrEagain is returned as an error
+// since it breaks the draining contract. FlushBuffers must be called before the decoder can be used again.
+func (cc *CodecContext) Drain(fn func(f *Frame) error) error {
+	if err := cc.SendPacket(nil); err != nil && !errors.Is(err, ErrEof) {
+		return fmt.Errorf("astiav: sending nil packet failed: %w", err)
+	}
+
+	f := AllocFrame()
+	defer f.Free()
+	for {
+		if err := cc.ReceiveFrame(f); err != nil {
+			if errors.Is(err, ErrEof) {
+				return nil
+			}
+			return fmt.Errorf("astiav: receiving frame failed: %w", err)
+		}
+		err := fn(f)
+		f.Unref()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// DrainPackets enters draining mode by sending a nil frame and sends every remaining packet to fn until
+// the encoder is fully drained. Packets are unreferenced once fn returns. Only encoders with the
+// CodecCapabilityFlagEncoderFlush capability can be used again, once FlushBuffers has been called. Other
+// encoders cannot be reused after draining. ErrEagain is returned as an error since it breaks the
+// draining contract.
+func (cc *CodecContext) DrainPackets(fn func(p *Packet) error) error {
+	if err := cc.SendFrame(nil); err != nil && !errors.Is(err, ErrEof) {
+		return fmt.Errorf("astiav: sending nil frame failed: %w", err)
+	}
+
+	p := AllocPacket()
+	defer p.Free()
+	for {
+		if err := cc.ReceivePacket(p); err != nil {
+			if errors.Is(err, ErrEof) {
+				return nil
+			}
+			return fmt.Errorf("astiav: receiving packet failed: %w", err)
+		}
+		err := fn(p)
+		p.Unref()
+		if err != nil {
+			return err
+		}
+	}
+}
+
 func (cc *CodecContext) ToCodecParameters(cp *CodecParameters) error {
 	return cp.FromCodecContext(cc)
 }
@@ -430,6 +640,20 @@ func (cc *CodecContext) SetHardwareFramesContext(hfc *HardwareFramesContext) {
 	}
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__lavc__decoding.html#ga7fc990105405e7958f8051cee81bee6b
+//
+// Returned hardware frames context is not initialized and must be freed by the caller
+func (cc *CodecContext) HardwareFramesParameters(hdc *HardwareDeviceContext, hardwarePixelFormat PixelFormat) (*HardwareFramesContext, error) {
+	if hdc == nil {
+		return nil, errors.New("astiav: hardware device context must not be nil")
+	}
+	var c *C.AVBufferRef
+	if err := newError(C.avcodec_get_hw_frames_parameters(cc.c, hdc.c, C.enum_AVPixelFormat(hardwarePixelFormat), &c)); err != nil {
+		return nil, err
+	}
+	return newHardwareFramesContextFromC(c), nil
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#ad2f772bd948d8f3be4d674a3a52ee00e
 func (cc *CodecContext) ExtraHardwareFrames() int {
 	return int(cc.c.extra_hw_frames)
@@ -528,3 +752,206 @@ func (cc *CodecContext) RateControlBufferSize() int {
 func (cc *CodecContext) SetRateControlBufferSize(n int) {
 	cc.c.rc_buffer_size = C.int(n)
 }
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#ab015db3b7fcd227193a7c17283914187
+func (cc *CodecContext) Qmax() int {
+	return int(cc.c.qmax)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#ab015db3b7fcd227193a7c17283914187
+func (cc *CodecContext) SetQmax(n int) {
+	cc.c.qmax = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#acf47505d34bd4b5a9292268f9aed1faa
+func (cc *CodecContext) Qcompress() float32 {
+	return float32(cc.c.qcompress)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#acf47505d34bd4b5a9292268f9aed1faa
+func (cc *CodecContext) SetQcompress(q float32) {
+	cc.c.qcompress = C.float(q)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a209f5ec60cb5f0b0a4962f4c5c5bb541
+func (cc *CodecContext) GlobalQuality() int {
+	return int(cc.c.global_quality)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a209f5ec60cb5f0b0a4962f4c5c5bb541
+func (cc *CodecContext) SetGlobalQuality(n int) {
+	cc.c.global_quality = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#aa401ca663644caa51ede1889659c23d6
+func (cc *CodecContext) CompressionLevel() int {
+	return int(cc.c.compression_level)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#aa401ca663644caa51ede1889659c23d6
+func (cc *CodecContext) SetCompressionLevel(n int) {
+	cc.c.compression_level = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a3f920af17b8b15cc9d9465ecb732afcb
+func (cc *CodecContext) KeyintMin() int {
+	return int(cc.c.keyint_min)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a3f920af17b8b15cc9d9465ecb732afcb
+func (cc *CodecContext) SetKeyintMin(n int) {
+	cc.c.keyint_min = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#aa0cb7241b4624dba761c8cf58fb2d5f0
+func (cc *CodecContext) Refs() int {
+	return int(cc.c.refs)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#aa0cb7241b4624dba761c8cf58fb2d5f0
+func (cc *CodecContext) SetRefs(n int) {
+	cc.c.refs = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a025940af0d5249418d6ac7e183fdd40f
+func (cc *CodecContext) Trellis() int {
+	return int(cc.c.trellis)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a025940af0d5249418d6ac7e183fdd40f
+func (cc *CodecContext) SetTrellis(n int) {
+	cc.c.trellis = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a65f37abbfc9d4630aa7fd44b9a1ebb21
+func (cc *CodecContext) BitRateTolerance() int {
+	return int(cc.c.bit_rate_tolerance)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a65f37abbfc9d4630aa7fd44b9a1ebb21
+func (cc *CodecContext) SetBitRateTolerance(n int) {
+	cc.c.bit_rate_tolerance = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a7546ebfa9e9ffede316576dced7e150c
+func (cc *CodecContext) RateControlInitialBufferOccupancy() int {
+	return int(cc.c.rc_initial_buffer_occupancy)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a7546ebfa9e9ffede316576dced7e150c
+func (cc *CodecContext) SetRateControlInitialBufferOccupancy(n int) {
+	cc.c.rc_initial_buffer_occupancy = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a948993adfdfcd64b81dad1151fe50f33
+func (cc *CodecContext) Delay() int {
+	return int(cc.c.delay)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a686a77363668795c15c87b532cc455fa
+func (cc *CodecContext) HasBFrames() int {
+	return int(cc.c.has_b_frames)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a451c6f27c0b39063fd3b416763082920
+func (cc *CodecContext) FrameNumber() int64 {
+	return int64(cc.c.frame_num)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#aff4e6a78c0f08be43879644632e04b24
+func (cc *CodecContext) Slices() int {
+	return int(cc.c.slices)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#aff4e6a78c0f08be43879644632e04b24
+func (cc *CodecContext) SetSlices(n int) {
+	cc.c.slices = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a5d222eeeb0b54ab462af363bcb9273bc
+func (cc *CodecContext) FieldOrder() FieldOrder {
+	return FieldOrder(cc.c.field_order)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a5d222eeeb0b54ab462af363bcb9273bc
+func (cc *CodecContext) SetFieldOrder(o FieldOrder) {
+	cc.c.field_order = C.enum_AVFieldOrder(o)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a889b84d5b3657df4a4e45b17b87848f5
+func (cc *CodecContext) BitsPerRawSample() int {
+	return int(cc.c.bits_per_raw_sample)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a889b84d5b3657df4a4e45b17b87848f5
+func (cc *CodecContext) SetBitsPerRawSample(n int) {
+	cc.c.bits_per_raw_sample = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a4745c7455c317272c4e139d6f369936c
+func (cc *CodecContext) ApplyCropping() bool {
+	return cc.c.apply_cropping != 0
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a4745c7455c317272c4e139d6f369936c
+func (cc *CodecContext) SetApplyCropping(b bool) {
+	if b {
+		cc.c.apply_cropping = 1
+	} else {
+		cc.c.apply_cropping = 0
+	}
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#af260210a39ad4781d8d47ddac1541d04
+func (cc *CodecContext) Lowres() int {
+	return int(cc.c.lowres)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#af260210a39ad4781d8d47ddac1541d04
+func (cc *CodecContext) SetLowres(n int) {
+	cc.c.lowres = C.int(n)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a9fca29999231cacbaf1d4754d9a74997
+func (cc *CodecContext) SkipAlpha() bool {
+	return cc.c.skip_alpha != 0
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a9fca29999231cacbaf1d4754d9a74997
+func (cc *CodecContext) SetSkipAlpha(b bool) {
+	if b {
+		cc.c.skip_alpha = 1
+	} else {
+		cc.c.skip_alpha = 0
+	}
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#af869b808363998c80adf7df6a944a5a6
+func (cc *CodecContext) SkipFrame() Discard {
+	return Discard(cc.c.skip_frame)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#af869b808363998c80adf7df6a944a5a6
+func (cc *CodecContext) SetSkipFrame(d Discard) {
+	cc.c.skip_frame = C.enum_AVDiscard(d)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#ac3d90275bfb1153a5b00ebc2dd32a689
+func (cc *CodecContext) SkipIdct() Discard {
+	return Discard(cc.c.skip_idct)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#ac3d90275bfb1153a5b00ebc2dd32a689
+func (cc *CodecContext) SetSkipIdct(d Discard) {
+	cc.c.skip_idct = C.enum_AVDiscard(d)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a6be328131743a97103b89e028e62e771
+func (cc *CodecContext) SkipLoopFilter() Discard {
+	return Discard(cc.c.skip_loop_filter)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecContext.html#a6be328131743a97103b89e028e62e771
+func (cc *CodecContext) SetSkipLoopFilter(d Discard) {
+	cc.c.skip_loop_filter = C.enum_AVDiscard(d)
+}
@@ -30,6 +30,7 @@ func TestCodecContext(t *testing.T) {
 	require.Equal(t, ColorRangeUnspecified, cc1.ColorRange())
 	require.Equal(t, ColorSpaceUnspecified, cc1.ColorSpace())
 	require.Equal(t, ColorTransferCharacteristicUnspecified, cc1.ColorTransferCharacteristic())
+	require.Equal(t, FieldOrderProgressive, cc1.FieldOrder())
 	require.Equal(t, 12, cc1.GopSize())
 	require.Equal(t, 180, cc1.Height())
 	require.Equal(t, Level(13), cc1.Level())
@@ -110,6 +111,18 @@ func TestCodecContext(t *testing.T) {
 	cc4.SetRateControlMaxRate(1_500_000)
 	cc4.SetRateControlMinRate(1_500_000)
 	cc4.SetRateControlBufferSize(1_500_000)
+	cc4.SetBitRateTolerance(17)
+	cc4.SetBitsPerRawSample(18)
+	cc4.SetCompressionLevel(19)
+	cc4.SetFieldOrder(FieldOrderTt)
+	cc4.SetGlobalQuality(20)
+	cc4.SetKeyintMin(21)
+	cc4.SetQcompress(0.25)
+	cc4.SetQmax(22)
+	cc4.SetRateControlInitialBufferOccupancy(23)
+	cc4.SetRefs(24)
+	cc4.SetSlices(25)
+	cc4.SetTrellis(26)
 	require.Equal(t, int64(1), cc4.BitRate())
 	require.True(t, cc4.ChannelLayout().Equal(ChannelLayout21))
 	require.Equal(t, ColorPrimariesBt2020, cc4.ColorPrimaries())
@@ -139,6 +152,39 @@ func TestCodecContext(t *testing.T) {
 	require.Equal(t, int64(1_500_000), cc4.RateControlMaxRate())
 	require.Equal(t, int64(1_500_000), cc4.RateControlMinRate())
 	require.Equal(t, 1_500_000, cc4.RateControlBufferSize())
+	require.Equal(t, 17, cc4.BitRateTolerance())
+	require.Equal(t, 18, cc4.BitsPerRawSample())
+	require.Equal(t, 19, cc4.CompressionLevel())
+	require.Equal(t, FieldOrderTt, cc4.FieldOrder())
+	require.Equal(t, 20, cc4.GlobalQuality())
+	require.Equal(t, 21, cc4.KeyintMin())
+	require.Equal(t, float32(0.25), cc4.Qcompress())
+	require.Equal(t, 22, cc4.Qmax())
+	require.Equal(t, 23, cc4.RateControlInitialBufferOccupancy())
+	require.Equal(t, 24, cc4.Refs())
+	require.Equal(t, 25, cc4.Slices())
+	require.Equal(t, 26, cc4.Trellis())
+	require.Equal(t, 0, cc4.Delay())
+	require.Equal(t, 0, cc4.HasBFrames())
+	require.Equal(t, int64(0), cc4.FrameNumber())
+	require.True(t, cc4.ApplyCropping())
+	require.Equal(t, 0, cc4.Lowres())
+	require.False(t, cc4.SkipAlpha())
+	require.Equal(t, DiscardDefault, cc4.SkipFrame())
+	require.Equal(t, DiscardDefault, cc4.SkipIdct())
+	require.Equal(t, DiscardDefault, cc4.SkipLoopFilter())
+	cc4.SetApplyCropping(false)
+	cc4.SetLowres(1)
+	cc4.SetSkipAlpha(true)
+	cc4.SetSkipFrame(DiscardNonKey)
+	cc4.SetSkipIdct(DiscardNonRef)
+	cc4.SetSkipLoopFilter(DiscardAll)
+	require.False(t, cc4.ApplyCropping())
+	require.Equal(t, 1, cc4.Lowres())
+	require.True(t, cc4.SkipAlpha())
+	require.Equal(t, DiscardNonKey, cc4.SkipFrame())
+	require.Equal(t, DiscardNonRef, cc4.SkipIdct())
+	require.Equal(t, DiscardAll, cc4.SkipLoopFilter())
 
 	cc5 := AllocCodecContext(nil)
 	require.NotNil(t, cc5)
@@ -160,8 +206,173 @@ func TestCodecContext(t *testing.T) {
 	require.NoError(t, cc6.SetExtraData(b))
 	require.Equal(t, b, cc6.ExtraData())
 
+	c7 := FindEncoder(CodecIDMpeg4)
+	require.NotNil(t, c7)
+	cc7 := AllocCodecContext(c7)
+	require.NotNil(t, cc7)
+	defer cc7.Free()
+	cc7.SetBitRate(100000)
+	cc7.SetFlags(NewCodecContextFlags(CodecContextFlagPsnr))
+	cc7.SetHeight(64)
+	cc7.SetPixelFormat(PixelFormatYuv420P)
+	cc7.SetRateControlBufferSize(200000)
+	cc7.SetRateControlMaxRate(150000)
+	cc7.SetTimeBase(NewRational(1, 25))
+	cc7.SetWidth(64)
+	require.NoError(t, cc7.Open(c7, nil))
+	cpb, ok := cc7.CodedSideData().CPBProperties().Get()
+	require.True(t, ok)
+	require.Equal(t, int64(150000), cpb.MaxBitrate)
+	require.Equal(t, int64(200000), cpb.BufferSize)
+	f1 := AllocFrame()
+	require.NotNil(t, f1)
+	defer f1.Free()
+	f1.SetHeight(64)
+	f1.SetPixelFormat(PixelFormatYuv420P)
+	f1.SetWidth(64)
+	require.NoError(t, f1.AllocBuffer(0))
+	require.NoError(t, f1.ImageFillBlack())
+	f1.SetPts(0)
+	require.NoError(t, cc7.SendFrame(f1))
+	require.NoError(t, cc7.SendFrame(nil))
+	pkt1 := AllocPacket()
+	require.NotNil(t, pkt1)
+	defer pkt1.Free()
+	require.NoError(t, cc7.ReceivePacket(pkt1))
+	qs, ok := pkt1.SideData().QualityStats().Get()
+	require.True(t, ok)
+	require.Equal(t, PictureTypeI, qs.PictureType)
+	require.Greater(t, qs.Quality, uint32(0))
+	require.Len(t, qs.Errors, 3)
+	require.True(t, cc7.IsOpen())
+	require.Equal(t, c7.Name(), cc7.Codec().Name())
+	require.Error(t, cc7.FlushBuffers())
+	r, err := cc7.Reconfigure(200000, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, CodecContextReconfigureResult{}, r)
+	require.Equal(t, int64(100000), cc7.BitRate())
+
+	require.False(t, cc4.IsOpen())
+	r, err = cc4.Reconfigure(2, 3, 4)
+	require.NoError(t, err)
+	require.Equal(t, CodecContextReconfigureResult{BitRate: true, BufferSize: true, MaxRate: true}, r)
+	require.Equal(t, int64(2), cc4.BitRate())
+	require.Equal(t, int64(3), cc4.RateControlMaxRate())
+	require.Equal(t, 4, cc4.RateControlBufferSize())
+	r, err = cc4.Reconfigure(0, 0, 5)
+	require.NoError(t, err)
+	require.Equal(t, CodecContextReconfigureResult{BufferSize: true}, r)
+	require.Equal(t, int64(2), cc4.BitRate())
+
+	require.Error(t, cc2.FlushBuffers())
+	require.NoError(t, cc2.Open(c2, nil))
+	require.NoError(t, cc2.FlushBuffers())
+
+	cc8 := AllocCodecContext(c7)
+	require.NotNil(t, cc8)
+	defer cc8.Free()
+	cc8.SetHeight(64)
+	cc8.SetPixelFormat(PixelFormatYuv420P)
+	cc8.SetTimeBase(NewRational(1, 25))
+	cc8.SetWidth(64)
+	require.NoError(t, cc8.Open(c7, nil))
+	for i := 0; i < 3; i++ {
+		f1.SetPts(int64(i))
+		require.NoError(t, cc8.SendFrame(f1))
+	}
+	var pts []int64
+	require.NoError(t, cc8.DrainPackets(func(p *Packet) error {
+		pts = append(pts, p.Pts())
+		return nil
+	}))
+	require.Equal(t, []int64{0, 1, 2}, pts)
+	require.NoError(t, cc8.DrainPackets(func(p *Packet) error {
+		require.Fail(t, "encoder should be drained")
+		return nil
+	}))
+
+	fc9 := AllocFormatContext()
+	require.NotNil(t, fc9)
+	defer fc9.Free()
+	require.NoError(t, fc9.OpenInput("testdata/video.mp4", nil, nil))
+	defer fc9.CloseInput()
+	require.NoError(t, fc9.FindStreamInfo(nil))
+	cc9 := AllocCodecContext(c1)
+	require.NotNil(t, cc9)
+	defer cc9.Free()
+	require.NoError(t, fc9.Streams()[0].CodecParameters().ToCodecContext(cc9))
+	require.NoError(t, cc9.Open(c1, nil))
+	pkt2 := AllocPacket()
+	require.NotNil(t, pkt2)
+	defer pkt2.Free()
+	var nbPackets, nbFrames int
+	for nbPackets < 5 {
+		require.NoError(t, fc9.ReadFrame(pkt2))
+		if pkt2.StreamIndex() == 0 {
+			require.NoError(t, cc9.SendPacket(pkt2))
+			nbPackets++
+			for {
+				if err := cc9.ReceiveFrame(f1); err != nil {
+					require.ErrorIs(t, err, ErrEagain)
+					break
+				}
+				nbFrames++
+			}
+		}
+		pkt2.Unref()
+	}
+	require.NoError(t, cc9.Drain(func(f *Frame) error {
+		nbFrames++
+		return nil
+	}))
+	require.Equal(t, nbPackets, nbFrames)
+	require.NoError(t, cc9.FlushBuffers())
+
 	// TODO Test ReceivePacket
 	// TODO Test SendPacket
 	// TODO Test ReceiveFrame
 	// TODO Test SendFrame
 }
+
+func TestCodecContextReconfigure(t *testing.T) {
+	c := FindEncoderByName("libx264")
+	if c == nil {
+		t.Skip("libx264 is not available")
+	}
+
+	for _, v := range []struct {
+		crf      string
+		expected CodecContextReconfigureResult
+	}{
+		{expected: CodecContextReconfigureResult{BitRate: true, BufferSize: true, MaxRate: true}},
+		{crf: "23", expected: CodecContextReconfigureResult{BufferSize: true, MaxRate: true}},
+	} {
+		cc := AllocCodecContext(c)
+		require.NotNil(t, cc)
+		defer cc.Free()
+		cc.SetBitRate(100000)
+		cc.SetHeight(64)
+		cc.SetPixelFormat(PixelFormatYuv420P)
+		cc.SetRateControlBufferSize(200000)
+		cc.SetRateControlMaxRate(150000)
+		cc.SetTimeBase(NewRational(1, 25))
+		cc.SetWidth(64)
+		d := NewDictionary()
+		defer d.Free()
+		if v.crf != "" {
+			require.NoError(t, d.Set("crf", v.crf, 0))
+		}
+		require.NoError(t, cc.Open(c, d))
+
+		r, err := cc.Reconfigure(200000, 300000, 400000)
+		require.NoError(t, err)
+		require.Equal(t, v.expected, r)
+		if v.expected.BitRate {
+			require.Equal(t, int64(200000), cc.BitRate())
+		} else {
+			require.Equal(t, int64(100000), cc.BitRate())
+		}
+		require.Equal(t, int64(300000), cc.RateControlMaxRate())
+		require.Equal(t, 400000, cc.RateControlBufferSize())
+	}
+}
@@ -0,0 +1,89 @@
+package astiav
+
+//#include <libavcodec/avcodec.h>
+import "C"
+import "unsafe"
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecDescriptor.html
+type CodecDescriptor struct {
+	c *C.AVCodecDescriptor
+}
+
+func newCodecDescriptorFromC(c *C.AVCodecDescriptor) *CodecDescriptor {
+	if c == nil {
+		return nil
+	}
+	return &CodecDescriptor{c: c}
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecDescriptor.html#ab7ba57d70e9d4d50bba20c778c09d069
+func (d *CodecDescriptor) ID() CodecID {
+	return CodecID(d.c.id)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecDescriptor.html#ad628ddb3416cafa38c9d4f3c1f61ad50
+func (d *CodecDescriptor) MediaType() MediaType {
+	return MediaType(d.c._type)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecDescriptor.html#a955dba1de947abbed22d28682b0db516
+func (d *CodecDescriptor) Name() string {
+	return C.GoString(d.c.name)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecDescriptor.html#a31fa9d4fed68cfe8a128d461ec2d3e20
+func (d *CodecDescriptor) LongName() string {
+	return C.GoString(d.c.long_name)
+}
+
+func (d *CodecDescriptor) String() string {
+	return d.Name()
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecDescriptor.html#a9949288403a12812cd6e3892ac45f40f
+func (d *CodecDescriptor) Properties() CodecDescriptorPropertyFlags {
+	return CodecDescriptorPropertyFlags(d.c.props)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecDescriptor.html#a15fdf65a032db42ffa532837f72f6921
+func (d *CodecDescriptor) MimeTypes() (ms []string) {
+	if d.c.mime_types == nil {
+		return
+	}
+	for i := uintptr(0); ; i++ {
+		m := *(**C.char)(unsafe.Pointer(uintptr(unsafe.Pointer(d.c.mime_types)) + i*unsafe.Sizeof(*d.c.mime_types)))
+		if m == nil {
+			break
+		}
+		ms = append(ms, C.GoString(m))
+	}
+	return
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecDescriptor.html#a74f654a27342fd93895dbafbc5c1928f
+func (d *CodecDescriptor) Profiles() []CodecProfile {
+	return newCodecProfilesFromC(d.c.profiles)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVProfile.html
+type CodecProfile struct {
+	Name    string
+	Profile Profile
+}
+
+func newCodecProfilesFromC(c *C.AVProfile) (ps []CodecProfile) {
+	if c == nil {
+		return
+	}
+	for i := uintptr(0); ; i++ {
+		p := (*C.AVProfile)(unsafe.Pointer(uintptr(unsafe.Pointer(c)) + i*uintptr(C.sizeof_AVProfile)))
+		if p.profile == C.AV_PROFILE_UNKNOWN {
+			break
+		}
+		ps = append(ps, CodecProfile{
+			Name:    C.GoString(p.name),
+			Profile: Profile(p.profile),
+		})
+	}
+	return
+}
@@ -0,0 +1,17 @@
+package astiav
+
+//#include <libavcodec/avcodec.h>
+import "C"
+
+// https://ffmpeg.org/doxygen/8.0/group__lavc__core.html
+type CodecDescriptorPropertyFlag int64
+
+const (
+	CodecDescriptorPropertyFlagBitmapSub = CodecDescriptorPropertyFlag(C.AV_CODEC_PROP_BITMAP_SUB)
+	CodecDescriptorPropertyFlagFields    = CodecDescriptorPropertyFlag(C.AV_CODEC_PROP_FIELDS)
+	CodecDescriptorPropertyFlagIntraOnly = CodecDescriptorPropertyFlag(C.AV_CODEC_PROP_INTRA_ONLY)
+	CodecDescriptorPropertyFlagLossless  = CodecDescriptorPropertyFlag(C.AV_CODEC_PROP_LOSSLESS)
+	CodecDescriptorPropertyFlagLossy     = CodecDescriptorPropertyFlag(C.AV_CODEC_PROP_LOSSY)
+	CodecDescriptorPropertyFlagReorder   = CodecDescriptorPropertyFlag(C.AV_CODEC_PROP_REORDER)
+	CodecDescriptorPropertyFlagTextSub   = CodecDescriptorPropertyFlag(C.AV_CODEC_PROP_TEXT_SUB)
+)
@@ -0,0 +1,28 @@
+package astiav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecDescriptor(t *testing.T) {
+	d := CodecIDH264.Descriptor()
+	require.NotNil(t, d)
+	require.Equal(t, CodecIDH264, d.ID())
+	require.Equal(t, MediaTypeVideo, d.MediaType())
+	require.Equal(t, "h264", d.Name())
+	require.Equal(t, "h264", d.String())
+	require.Equal(t, "H.264 / AVC / MPEG-4 AVC / MPEG-4 part 10", d.LongName())
+	require.True(t, d.Properties().Has(CodecDescriptorPropertyFlagReorder))
+	require.False(t, d.Properties().Has(CodecDescriptorPropertyFlagIntraOnly))
+	require.Nil(t, d.MimeTypes())
+	require.Contains(t, d.Profiles(), CodecProfile{Name: "Constrained Baseline", Profile: ProfileH264ConstrainedBaseline})
+
+	d = CodecIDPng.Descriptor()
+	require.NotNil(t, d)
+	require.True(t, d.Properties().Has(CodecDescriptorPropertyFlagIntraOnly))
+	require.True(t, d.Properties().Has(CodecDescriptorPropertyFlagLossless))
+	require.Equal(t, []string{"image/png"}, d.MimeTypes())
+	require.Nil(t, d.Profiles())
+}
@@ -26,3 +26,46 @@ func (chc CodecHardwareConfig) MethodFlags() CodecHardwareConfigMethodFlags {
 func (chc CodecHardwareConfig) PixelFormat() PixelFormat {
 	return PixelFormat(chc.c.pix_fmt)
 }
+
+type CodecHardwareConfigProbe struct {
+	Codec  *Codec
+	Config CodecHardwareConfig
+	// Error returned when creating a device of the config's type on this machine, nil if it succeeded
+	DeviceError error
+}
+
+func (p CodecHardwareConfigProbe) DeviceAvailable() bool {
+	return p.DeviceError == nil
+}
+
+// ProbeCodecHardwareConfigs returns the hardware configs of every decoder and encoder of the codec id,
+// and tries to create a device of each config's type with default parameters. Configs without device
+// type are skipped.
+func ProbeCodecHardwareConfigs(id CodecID) (ps []CodecHardwareConfigProbe) {
+	errs := make(map[HardwareDeviceType]error)
+	for _, c := range Codecs() {
+		if c.ID() != id {
+			continue
+		}
+		for _, config := range c.HardwareConfigs() {
+			t := config.HardwareDeviceType()
+			if t == HardwareDeviceTypeNone {
+				continue
+			}
+			err, ok := errs[t]
+			if !ok {
+				var hdc *HardwareDeviceContext
+				if hdc, err = CreateHardwareDeviceContext(t, "", nil, 0); err == nil {
+					hdc.Free()
+				}
+				errs[t] = err
+			}
+			ps = append(ps, CodecHardwareConfigProbe{
+				Codec:       c,
+				Config:      config,
+				DeviceError: err,
+			})
+		}
+	}
+	return
+}
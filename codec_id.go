@@ -567,6 +567,11 @@ func (c CodecID) MediaType() MediaType {
 	return MediaType(C.avcodec_get_type((C.enum_AVCodecID)(c)))
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__lavc__core.html#gac09f8ddc2d4b36c5a85c6befba0d0888
+func (c CodecID) Descriptor() *CodecDescriptor {
+	return newCodecDescriptorFromC(C.avcodec_descriptor_get((C.enum_AVCodecID)(c)))
+}
+
 func (c CodecID) Name() string {
 	return C.GoString(C.avcodec_get_name((C.enum_AVCodecID)(c)))
 }
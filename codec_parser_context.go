@@ -0,0 +1,66 @@
+package astiav
+
+//#include <libavcodec/avcodec.h>
+import "C"
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html
+type CodecParserContext struct {
+	c *C.AVCodecParserContext
+}
+
+func newCodecParserContextFromC(c *C.AVCodecParserContext) *CodecParserContext {
+	if c == nil {
+		return nil
+	}
+	return &CodecParserContext{c: c}
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html#ab667ba2d0988ee3bb25497b0176f261a
+func (pc *CodecParserContext) CodedHeight() int {
+	return int(pc.c.coded_height)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html#ada69849c61450b19d1056b0c739299fe
+func (pc *CodecParserContext) CodedWidth() int {
+	return int(pc.c.coded_width)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html#ae3146f05127d9d4ba5a140a869bdab35
+func (pc *CodecParserContext) Dts() int64 {
+	return int64(pc.c.dts)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html#a0e64b20802c2a2fbaa998ba7ee5ecd2e
+func (pc *CodecParserContext) Height() int {
+	return int(pc.c.height)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html#ac115e048335e4a7f1d85541cebcf2013
+func (pc *CodecParserContext) KeyFrame() bool {
+	return pc.c.key_frame == 1
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html#a41702bcd99e8aeab875634fb44bfd741
+func (pc *CodecParserContext) PictureType() PictureType {
+	return PictureType(pc.c.pict_type)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html#a6cb1cf94ba19b6df4aa2c3c0a43beac7
+func (pc *CodecParserContext) Pos() int64 {
+	return int64(pc.c.pos)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html#a7e0b385e4ec6765ab0ae0db11565dd1d
+func (pc *CodecParserContext) Pts() int64 {
+	return int64(pc.c.pts)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html#a813780022f0a6abd1b7ab075264ffc3f
+func (pc *CodecParserContext) RepeatPict() int {
+	return int(pc.c.repeat_pict)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParserContext.html#a419a9c8156d65870b8ba6bc037f112a2
+func (pc *CodecParserContext) Width() int {
+	return int(pc.c.width)
+}
@@ -45,9 +45,15 @@ func TestCodec(t *testing.T) {
 	require.Equal(t, "aac", c.Name())
 	require.Equal(t, "AAC (Advanced Audio Coding)", c.LongName())
 	require.Equal(t, "aac", c.String())
+	require.True(t, c.Capabilities().Has(CodecCapabilityFlagChannelConf))
+	require.False(t, c.Capabilities().Has(CodecCapabilityFlagHardware))
+	require.Contains(t, c.Profiles(), CodecProfile{Name: "LC", Profile: ProfileAacLow})
+	require.Equal(t, "", c.WrapperName())
 
 	c = FindEncoderByName("aac")
 	require.NotNil(t, c)
+	require.True(t, c.Capabilities().Has(CodecCapabilityFlagDelay))
+	require.True(t, c.Capabilities().Has(CodecCapabilityFlagSmallLastFrame))
 	require.Equal(t, []int{96000, 88200, 64000, 48000, 44100, 32000, 24000, 22050, 16000, 12000, 11025, 8000, 7350}, c.SupportedSampleRates())
 
 	c = FindEncoder(CodecIDMjpeg)
@@ -107,3 +113,12 @@ func TestCodec(t *testing.T) {
 	}
 	require.True(t, found)
 }
+
+func TestProbeCodecHardwareConfigs(t *testing.T) {
+	require.Empty(t, ProbeCodecHardwareConfigs(CodecIDPcmS16Le))
+	for _, p := range ProbeCodecHardwareConfigs(CodecIDH264) {
+		require.Equal(t, CodecIDH264, p.Codec.ID())
+		require.NotEqual(t, HardwareDeviceTypeNone, p.Config.HardwareDeviceType())
+		require.Equal(t, p.DeviceError == nil, p.DeviceAvailable())
+	}
+}
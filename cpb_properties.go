@@ -0,0 +1,42 @@
+package astiav
+
+//#include <libavcodec/avcodec.h>
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+// https://ffmpeg.org/doxygen/8.0/structAVCPBProperties.html
+type CPBProperties struct {
+	AvgBitrate int64
+	BufferSize int64
+	MaxBitrate int64
+	MinBitrate int64
+	VbvDelay   uint64
+}
+
+func newCPBPropertiesFromBytes(b []byte) (*CPBProperties, error) {
+	if len(b) < C.sizeof_AVCPBProperties {
+		return nil, fmt.Errorf("astiav: invalid length %d < %d", len(b), C.sizeof_AVCPBProperties)
+	}
+	c := (*C.AVCPBProperties)(unsafe.Pointer(&b[0]))
+	return &CPBProperties{
+		AvgBitrate: int64(c.avg_bitrate),
+		BufferSize: int64(c.buffer_size),
+		MaxBitrate: int64(c.max_bitrate),
+		MinBitrate: int64(c.min_bitrate),
+		VbvDelay:   uint64(c.vbv_delay),
+	}, nil
+}
+
+func (p *CPBProperties) bytes() []byte {
+	c := C.AVCPBProperties{
+		avg_bitrate: C.int64_t(p.AvgBitrate),
+		buffer_size: C.int64_t(p.BufferSize),
+		max_bitrate: C.int64_t(p.MaxBitrate),
+		min_bitrate: C.int64_t(p.MinBitrate),
+		vbv_delay:   C.uint64_t(p.VbvDelay),
+	}
+	return C.GoBytes(unsafe.Pointer(&c), C.sizeof_AVCPBProperties)
+}
@@ -0,0 +1,22 @@
+package astiav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPBProperties(t *testing.T) {
+	_, err := newCPBPropertiesFromBytes([]byte("123"))
+	require.Error(t, err)
+	p1 := &CPBProperties{
+		AvgBitrate: 1,
+		BufferSize: 2,
+		MaxBitrate: 3,
+		MinBitrate: 4,
+		VbvDelay:   5,
+	}
+	p2, err := newCPBPropertiesFromBytes(p1.bytes())
+	require.NoError(t, err)
+	require.Equal(t, p1, p2)
+}
@@ -18,6 +18,7 @@ const (
 	ErrEio              = Error(-(C.EIO))
 	ErrEncoderNotFound  = Error(C.AVERROR_ENCODER_NOT_FOUND)
 	ErrEnoent           = Error(-(C.ENOENT))
+	ErrEnosys           = Error(-(C.ENOSYS))
 	ErrEof              = Error(C.AVERROR_EOF)
 	ErrEperm            = Error(-(C.EPERM))
 	ErrEpipe            = Error(-(C.EPIPE))
@@ -0,0 +1,16 @@
+package astiav
+
+//#include <libavcodec/avcodec.h>
+import "C"
+
+// https://ffmpeg.org/doxygen/8.0/group__lavc__core.html
+type FieldOrder C.enum_AVFieldOrder
+
+const (
+	FieldOrderUnknown     = FieldOrder(C.AV_FIELD_UNKNOWN)
+	FieldOrderProgressive = FieldOrder(C.AV_FIELD_PROGRESSIVE)
+	FieldOrderTt          = FieldOrder(C.AV_FIELD_TT)
+	FieldOrderBb          = FieldOrder(C.AV_FIELD_BB)
+	FieldOrderTb          = FieldOrder(C.AV_FIELD_TB)
+	FieldOrderBt          = FieldOrder(C.AV_FIELD_BT)
+)
@@ -45,6 +45,26 @@ func (fs BuffersrcFlags) Del(f BuffersrcFlag) BuffersrcFlags {
 
 func (fs BuffersrcFlags) Has(f BuffersrcFlag) bool { return astikit.BitFlags(fs).Has(uint64(f)) }
 
+type CodecCapabilityFlags astikit.BitFlags
+
+func NewCodecCapabilityFlags(fs ...CodecCapabilityFlag) CodecCapabilityFlags {
+	o := CodecCapabilityFlags(0)
+	for _, f := range fs {
+		o = o.Add(f)
+	}
+	return o
+}
+
+func (fs CodecCapabilityFlags) Add(f CodecCapabilityFlag) CodecCapabilityFlags {
+	return CodecCapabilityFlags(astikit.BitFlags(fs).Add(uint64(f)))
+}
+
+func (fs CodecCapabilityFlags) Del(f CodecCapabilityFlag) CodecCapabilityFlags {
+	return CodecCapabilityFlags(astikit.BitFlags(fs).Del(uint64(f)))
+}
+
+func (fs CodecCapabilityFlags) Has(f CodecCapabilityFlag) bool { return astikit.BitFlags(fs).Has(uint64(f)) }
+
 type CodecContextFlags astikit.BitFlags
 
 func NewCodecContextFlags(fs ...CodecContextFlag) CodecContextFlags {
@@ -85,6 +105,26 @@ func (fs CodecContextFlags2) Del(f CodecContextFlag2) CodecContextFlags2 {
 
 func (fs CodecContextFlags2) Has(f CodecContextFlag2) bool { return astikit.BitFlags(fs).Has(uint64(f)) }
 
+type CodecDescriptorPropertyFlags astikit.BitFlags
+
+func NewCodecDescriptorPropertyFlags(fs ...CodecDescriptorPropertyFlag) CodecDescriptorPropertyFlags {
+	o := CodecDescriptorPropertyFlags(0)
+	for _, f := range fs {
+		o = o.Add(f)
+	}
+	return o
+}
+
+func (fs CodecDescriptorPropertyFlags) Add(f CodecDescriptorPropertyFlag) CodecDescriptorPropertyFlags {
+	return CodecDescriptorPropertyFlags(astikit.BitFlags(fs).Add(uint64(f)))
+}
+
+func (fs CodecDescriptorPropertyFlags) Del(f CodecDescriptorPropertyFlag) CodecDescriptorPropertyFlags {
+	return CodecDescriptorPropertyFlags(astikit.BitFlags(fs).Del(uint64(f)))
+}
+
+func (fs CodecDescriptorPropertyFlags) Has(f CodecDescriptorPropertyFlag) bool { return astikit.BitFlags(fs).Has(uint64(f)) }
+
 type CodecHardwareConfigMethodFlags astikit.BitFlags
 
 func NewCodecHardwareConfigMethodFlags(fs ...CodecHardwareConfigMethodFlag) CodecHardwareConfigMethodFlags {
@@ -285,6 +325,26 @@ func (fs FrameFlags) Del(f FrameFlag) FrameFlags {
 
 func (fs FrameFlags) Has(f FrameFlag) bool { return astikit.BitFlags(fs).Has(uint64(f)) }
 
+type HardwareFrameMapFlags astikit.BitFlags
+
+func NewHardwareFrameMapFlags(fs ...HardwareFrameMapFlag) HardwareFrameMapFlags {
+	o := HardwareFrameMapFlags(0)
+	for _, f := range fs {
+		o = o.Add(f)
+	}
+	return o
+}
+
+func (fs HardwareFrameMapFlags) Add(f HardwareFrameMapFlag) HardwareFrameMapFlags {
+	return HardwareFrameMapFlags(astikit.BitFlags(fs).Add(uint64(f)))
+}
+
+func (fs HardwareFrameMapFlags) Del(f HardwareFrameMapFlag) HardwareFrameMapFlags {
+	return HardwareFrameMapFlags(astikit.BitFlags(fs).Del(uint64(f)))
+}
+
+func (fs HardwareFrameMapFlags) Has(f HardwareFrameMapFlag) bool { return astikit.BitFlags(fs).Has(uint64(f)) }
+
 type IOContextFlags astikit.BitFlags
 
 func NewIOContextFlags(fs ...IOContextFlag) IOContextFlags {
@@ -325,6 +385,26 @@ func (fs IOFormatFlags) Del(f IOFormatFlag) IOFormatFlags {
 
 func (fs IOFormatFlags) Has(f IOFormatFlag) bool { return astikit.BitFlags(fs).Has(uint64(f)) }
 
+type OptionFlags astikit.BitFlags
+
+func NewOptionFlags(fs ...OptionFlag) OptionFlags {
+	o := OptionFlags(0)
+	for _, f := range fs {
+		o = o.Add(f)
+	}
+	return o
+}
+
+func (fs OptionFlags) Add(f OptionFlag) OptionFlags {
+	return OptionFlags(astikit.BitFlags(fs).Add(uint64(f)))
+}
+
+func (fs OptionFlags) Del(f OptionFlag) OptionFlags {
+	return OptionFlags(astikit.BitFlags(fs).Del(uint64(f)))
+}
+
+func (fs OptionFlags) Has(f OptionFlag) bool { return astikit.BitFlags(fs).Has(uint64(f)) }
+
 type OptionSearchFlags astikit.BitFlags
 
 func NewOptionSearchFlags(fs ...OptionSearchFlag) OptionSearchFlags {
@@ -385,6 +465,26 @@ func (fs PixelFormatDescriptorFlags) Del(f PixelFormatDescriptorFlag) PixelForma
 
 func (fs PixelFormatDescriptorFlags) Has(f PixelFormatDescriptorFlag) bool { return astikit.BitFlags(fs).Has(uint64(f)) }
 
+type PixelFormatLossFlags astikit.BitFlags
+
+func NewPixelFormatLossFlags(fs ...PixelFormatLossFlag) PixelFormatLossFlags {
+	o := PixelFormatLossFlags(0)
+	for _, f := range fs {
+		o = o.Add(f)
+	}
+	return o
+}
+
+func (fs PixelFormatLossFlags) Add(f PixelFormatLossFlag) PixelFormatLossFlags {
+	return PixelFormatLossFlags(astikit.BitFlags(fs).Add(uint64(f)))
+}
+
+func (fs PixelFormatLossFlags) Del(f PixelFormatLossFlag) PixelFormatLossFlags {
+	return PixelFormatLossFlags(astikit.BitFlags(fs).Del(uint64(f)))
+}
+
+func (fs PixelFormatLossFlags) Has(f PixelFormatLossFlag) bool { return astikit.BitFlags(fs).Has(uint64(f)) }
+
 type SeekFlags astikit.BitFlags
 
 func NewSeekFlags(fs ...SeekFlag) SeekFlags {
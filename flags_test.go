@@ -25,6 +25,15 @@ func TestBuffersrcFlags(t *testing.T) {
 	require.False(t, fs.Has(BuffersrcFlag(2)))
 }
 
+func TestCodecCapabilityFlags(t *testing.T) {
+	fs := NewCodecCapabilityFlags(CodecCapabilityFlag(1))
+	require.True(t, fs.Has(CodecCapabilityFlag(1)))
+	fs = fs.Add(CodecCapabilityFlag(2))
+	require.True(t, fs.Has(CodecCapabilityFlag(2)))
+	fs = fs.Del(CodecCapabilityFlag(2))
+	require.False(t, fs.Has(CodecCapabilityFlag(2)))
+}
+
 func TestCodecContextFlags(t *testing.T) {
 	fs := NewCodecContextFlags(CodecContextFlag(1))
 	require.True(t, fs.Has(CodecContextFlag(1)))
@@ -43,6 +52,15 @@ func TestCodecContextFlags2(t *testing.T) {
 	require.False(t, fs.Has(CodecContextFlag2(2)))
 }
 
+func TestCodecDescriptorPropertyFlags(t *testing.T) {
+	fs := NewCodecDescriptorPropertyFlags(CodecDescriptorPropertyFlag(1))
+	require.True(t, fs.Has(CodecDescriptorPropertyFlag(1)))
+	fs = fs.Add(CodecDescriptorPropertyFlag(2))
+	require.True(t, fs.Has(CodecDescriptorPropertyFlag(2)))
+	fs = fs.Del(CodecDescriptorPropertyFlag(2))
+	require.False(t, fs.Has(CodecDescriptorPropertyFlag(2)))
+}
+
 func TestCodecHardwareConfigMethodFlags(t *testing.T) {
 	fs := NewCodecHardwareConfigMethodFlags(CodecHardwareConfigMethodFlag(1))
 	require.True(t, fs.Has(CodecHardwareConfigMethodFlag(1)))
@@ -133,6 +151,15 @@ func TestFrameFlags(t *testing.T) {
 	require.False(t, fs.Has(FrameFlag(2)))
 }
 
+func TestHardwareFrameMapFlags(t *testing.T) {
+	fs := NewHardwareFrameMapFlags(HardwareFrameMapFlag(1))
+	require.True(t, fs.Has(HardwareFrameMapFlag(1)))
+	fs = fs.Add(HardwareFrameMapFlag(2))
+	require.True(t, fs.Has(HardwareFrameMapFlag(2)))
+	fs = fs.Del(HardwareFrameMapFlag(2))
+	require.False(t, fs.Has(HardwareFrameMapFlag(2)))
+}
+
 func TestIOContextFlags(t *testing.T) {
 	fs := NewIOContextFlags(IOContextFlag(1))
 	require.True(t, fs.Has(IOContextFlag(1)))
@@ -151,6 +178,15 @@ func TestIOFormatFlags(t *testing.T) {
 	require.False(t, fs.Has(IOFormatFlag(2)))
 }
 
+func TestOptionFlags(t *testing.T) {
+	fs := NewOptionFlags(OptionFlag(1))
+	require.True(t, fs.Has(OptionFlag(1)))
+	fs = fs.Add(OptionFlag(2))
+	require.True(t, fs.Has(OptionFlag(2)))
+	fs = fs.Del(OptionFlag(2))
+	require.False(t, fs.Has(OptionFlag(2)))
+}
+
 func TestOptionSearchFlags(t *testing.T) {
 	fs := NewOptionSearchFlags(OptionSearchFlag(1))
 	require.True(t, fs.Has(OptionSearchFlag(1)))
@@ -178,6 +214,15 @@ func TestPixelFormatDescriptorFlags(t *testing.T) {
 	require.False(t, fs.Has(PixelFormatDescriptorFlag(2)))
 }
 
+func TestPixelFormatLossFlags(t *testing.T) {
+	fs := NewPixelFormatLossFlags(PixelFormatLossFlag(1))
+	require.True(t, fs.Has(PixelFormatLossFlag(1)))
+	fs = fs.Add(PixelFormatLossFlag(2))
+	require.True(t, fs.Has(PixelFormatLossFlag(2)))
+	fs = fs.Del(PixelFormatLossFlag(2))
+	require.False(t, fs.Has(PixelFormatLossFlag(2)))
+}
+
 func TestSeekFlags(t *testing.T) {
 	fs := NewSeekFlags(SeekFlag(1))
 	require.True(t, fs.Has(SeekFlag(1)))
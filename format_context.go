@@ -4,14 +4,22 @@ package astiav
 //#include <libavformat/avformat.h>
 import "C"
 import (
+	"errors"
 	"fmt"
 	"math"
+	"time"
 	"unsafe"
 )
 
 // https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html
 type FormatContext struct {
-	c *C.AVFormatContext
+	c        *C.AVFormatContext
+	realtime *formatContextRealtime
+}
+
+type formatContextRealtime struct {
+	dts   int64
+	start int64
 }
 
 func newFormatContextFromC(c *C.AVFormatContext) *FormatContext {
@@ -69,6 +77,16 @@ func (fc *FormatContext) Free() {
 	}
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a032c7d859883eddc9d87a9e3b2cc3853
+func (fc *FormatContext) AvoidNegativeTs() AvoidNegativeTs {
+	return AvoidNegativeTs(fc.c.avoid_negative_ts)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a032c7d859883eddc9d87a9e3b2cc3853
+func (fc *FormatContext) SetAvoidNegativeTs(v AvoidNegativeTs) {
+	fc.c.avoid_negative_ts = C.int(v)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a972a02b9e3b542a426e323a8f8e3ea41
 func (fc *FormatContext) BitRate() int64 {
 	return int64(fc.c.bit_rate)
@@ -116,6 +134,26 @@ func (fc *FormatContext) SetIOInterrupter(i *IOInterrupter) {
 	}
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a7d1f665ecabe03e3d18accbb1ebb32b1
+func (fc *FormatContext) FormatProbeSize() int {
+	return int(fc.c.format_probesize)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a7d1f665ecabe03e3d18accbb1ebb32b1
+func (fc *FormatContext) SetFormatProbeSize(v int) {
+	fc.c.format_probesize = C.int(v)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a517e6b5dcada506a49ef413d4d8d477a
+func (fc *FormatContext) FpsProbeSize() int {
+	return int(fc.c.fps_probe_size)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a517e6b5dcada506a49ef413d4d8d477a
+func (fc *FormatContext) SetFpsProbeSize(v int) {
+	fc.c.fps_probe_size = C.int(v)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a6c01f25ef062e0398b0b55dd337246ed
 func (fc *FormatContext) InputFormat() *InputFormat {
 	return newInputFormatFromC(fc.c.iformat)
@@ -131,6 +169,41 @@ func (fc *FormatContext) MaxAnalyzeDuration() int64 {
 	return int64(fc.c.max_analyze_duration)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a4d860662c014f88277c8f20e238fa694
+func (fc *FormatContext) SetMaxAnalyzeDuration(d int64) {
+	fc.c.max_analyze_duration = C.int64_t(d)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a58422ed3d461b3440a15cf057ac5f5b7
+func (fc *FormatContext) MaxDelay() int {
+	return int(fc.c.max_delay)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a58422ed3d461b3440a15cf057ac5f5b7
+func (fc *FormatContext) SetMaxDelay(v int) {
+	fc.c.max_delay = C.int(v)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a00edb6b7a31f8dabfa668334ca1f92d7
+func (fc *FormatContext) MaxInterleaveDelta() int64 {
+	return int64(fc.c.max_interleave_delta)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a00edb6b7a31f8dabfa668334ca1f92d7
+func (fc *FormatContext) SetMaxInterleaveDelta(v int64) {
+	fc.c.max_interleave_delta = C.int64_t(v)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a11bf0a9a1ba16bc402c6a237b58b4da1
+func (fc *FormatContext) MaxStreams() int {
+	return int(fc.c.max_streams)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a11bf0a9a1ba16bc402c6a237b58b4da1
+func (fc *FormatContext) SetMaxStreams(v int) {
+	fc.c.max_streams = C.int(v)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a3019a56080ed2e3297ff25bc2ff88adf
 func (fc *FormatContext) Metadata() *Dictionary {
 	return newDictionaryFromC(fc.c.metadata)
@@ -145,6 +218,16 @@ func (fc *FormatContext) SetMetadata(d *Dictionary) {
 	}
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a6fc5a51cf62b6e8ad379c1ac57d4a0c4
+func (fc *FormatContext) MetadataHeaderPadding() int {
+	return int(fc.c.metadata_header_padding)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a6fc5a51cf62b6e8ad379c1ac57d4a0c4
+func (fc *FormatContext) SetMetadataHeaderPadding(v int) {
+	fc.c.metadata_header_padding = C.int(v)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a6f60043baf4abd0d201ccefc27bb4306
 func (fc *FormatContext) NbChapters() int {
 	return int(fc.c.nb_chapters)
@@ -191,6 +274,16 @@ func (fc *FormatContext) OutputFormat() *OutputFormat {
 	return newOutputFormatFromC(fc.c.oformat)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a711dc3e6159c44dc31843f915b893a12
+func (fc *FormatContext) OutputTsOffset() int64 {
+	return int64(fc.c.output_ts_offset)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a711dc3e6159c44dc31843f915b893a12
+func (fc *FormatContext) SetOutputTsOffset(v int64) {
+	fc.c.output_ts_offset = C.int64_t(v)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a1e7324262b6b78522e52064daaa7bc87
 func (fc *FormatContext) Pb() *IOContext {
 	// If the io context has been created using the format context's OpenInput() method, we need to
@@ -223,6 +316,26 @@ func (fc *FormatContext) ProbeScore() int {
 	return int(fc.c.probe_score)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#acb47a2a7362ac575b4986e0028786a41
+func (fc *FormatContext) ProbeSize() int64 {
+	return int64(fc.c.probesize)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#acb47a2a7362ac575b4986e0028786a41
+func (fc *FormatContext) SetProbeSize(v int64) {
+	fc.c.probesize = C.int64_t(v)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#aa5ddb5cee1df28f21739133f2e37f1c5
+func (fc *FormatContext) StartTimeRealtime() int64 {
+	return int64(fc.c.start_time_realtime)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#aa5ddb5cee1df28f21739133f2e37f1c5
+func (fc *FormatContext) SetStartTimeRealtime(v int64) {
+	fc.c.start_time_realtime = C.int64_t(v)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVFormatContext.html#a5017684cf0a84c990f60c8d50adec144
 func (fc *FormatContext) StrictStdCompliance() StrictStdCompliance {
 	return StrictStdCompliance(fc.c.strict_std_compliance)
@@ -325,11 +438,134 @@ func (fc *FormatContext) ReadFrame(p *Packet) error {
 	return newError(C.av_read_frame(fc.c, pc))
 }
 
+// ReadFrameRealtime reads the next packet and, like ffmpeg's -re option, waits until it is due based
+// on its dts and the time elapsed since the first packet was read. Pacing restarts after a seek.
+func (fc *FormatContext) ReadFrameRealtime(p *Packet) error {
+	if err := fc.ReadFrame(p); err != nil {
+		return err
+	}
+
+	// Get timestamp
+	ts := p.Dts()
+	if ts == NoPtsValue {
+		ts = p.Pts()
+	}
+	if ts == NoPtsValue {
+		return nil
+	}
+	ts = RescaleQ(ts, fc.Streams()[p.StreamIndex()].TimeBase(), TimeBaseQ)
+
+	// First packet
+	if fc.realtime == nil {
+		fc.realtime = &formatContextRealtime{
+			dts:   ts,
+			start: RelativeTime(),
+		}
+		return nil
+	}
+
+	// Wait
+	if d := fc.realtime.start + ts - fc.realtime.dts - RelativeTime(); d > 0 {
+		time.Sleep(time.Duration(d) * time.Microsecond)
+	}
+	return nil
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__decoding.html#ga27db687592d99f25ccf81a3b3ee8da9c
+func (fc *FormatContext) Pause() error {
+	return newError(C.av_read_pause(fc.c))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__decoding.html#ga7494bb63a59e79e9fe88eb1682d4d7b3
+func (fc *FormatContext) Play() error {
+	return newError(C.av_read_play(fc.c))
+}
+
 // https://ffmpeg.org/doxygen/8.0/group__lavf__decoding.html#gaa23f7619d8d4ea0857065d9979c75ac8
 func (fc *FormatContext) SeekFrame(streamIndex int, timestamp int64, f SeekFlags) error {
+	fc.realtime = nil
 	return newError(C.av_seek_frame(fc.c, C.int(streamIndex), C.int64_t(timestamp), C.int(f)))
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__lavf__decoding.html#ga3b40fc8d2fda6992ae6ea2567d71ba30
+func (fc *FormatContext) SeekFile(streamIndex int, minTimestamp, timestamp, maxTimestamp int64, f SeekFlags) error {
+	fc.realtime = nil
+	return newError(C.avformat_seek_file(fc.c, C.int(streamIndex), C.int64_t(minTimestamp), C.int64_t(timestamp), C.int64_t(maxTimestamp), C.int(f)))
+}
+
+// SeekExactFrame seeks to the keyframe preceding pts, flushes the codec contexts, and decodes packets of
+// the stream until the first frame whose pts is >= the requested pts (or whose pts is unknown) is found.
+// This frame is written in f.
+//
+// pts is expressed in the stream time base, cc must be the stream decoder and flush can contain additional
+// codec contexts (e.g. other streams' decoders) that need to be flushed after seeking. All codec contexts
+// must be open.
+func (fc *FormatContext) SeekExactFrame(streamIndex int, pts int64, cc *CodecContext, f *Frame, flush ...*CodecContext) error {
+	// Seek to the keyframe preceding pts
+	if err := fc.SeekFile(streamIndex, math.MinInt64, pts, pts, 0); err != nil {
+		return fmt.Errorf("astiav: seeking failed: %w", err)
+	}
+
+	// Flush codec contexts
+	for _, v := range append([]*CodecContext{cc}, flush...) {
+		if err := v.FlushBuffers(); err != nil {
+			return fmt.Errorf("astiav: flushing buffers failed: %w", err)
+		}
+	}
+
+	// Allocate packet
+	pkt := AllocPacket()
+	defer pkt.Free()
+
+	// Loop
+	eof := false
+	for {
+		// Read frame
+		if !eof {
+			if err := fc.ReadFrame(pkt); err != nil {
+				if !errors.Is(err, ErrEof) {
+					return fmt.Errorf("astiav: reading frame failed: %w", err)
+				}
+				eof = true
+			} else if pkt.StreamIndex() != streamIndex {
+				pkt.Unref()
+				continue
+			}
+		}
+
+		// Send packet
+		var err error
+		if eof {
+			err = cc.SendPacket(nil)
+		} else {
+			err = cc.SendPacket(pkt)
+			pkt.Unref()
+		}
+		if err != nil && !errors.Is(err, ErrEof) {
+			return fmt.Errorf("astiav: sending packet failed: %w", err)
+		}
+
+		// Loop
+		for {
+			// Receive frame
+			if err := cc.ReceiveFrame(f); err != nil {
+				if errors.Is(err, ErrEagain) {
+					break
+				}
+				return fmt.Errorf("astiav: receiving frame failed: %w", err)
+			}
+
+			// Frame has been found
+			if f.Pts() == NoPtsValue || f.Pts() >= pts {
+				return nil
+			}
+
+			// Discard frame
+			f.Unref()
+		}
+	}
+}
+
 // https://ffmpeg.org/doxygen/8.0/group__lavf__decoding.html#gaa03a82c5fd4fe3af312d229ca94cd6f3
 func (fc *FormatContext) Flush() error {
 	return newError(C.avformat_flush(fc.c))
@@ -367,6 +603,65 @@ func (fc *FormatContext) WriteTrailer() error {
 	return newError(C.av_write_trailer(fc.c))
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__lavf__encoding.html#ga169cfa28508e22e138c5b99be8517ea4
+//
+// Returns true if stream parameters were fully initialized by this call, false if they will only be
+// finalized by WriteHeader
+func (fc *FormatContext) InitOutput(d *Dictionary) (bool, error) {
+	var dc **C.AVDictionary
+	if d != nil {
+		dc = &d.c
+	}
+	ret := C.avformat_init_output(fc.c, dc)
+	if err := newError(ret); err != nil {
+		return false, err
+	}
+	return ret == C.AVSTREAM_INIT_IN_INIT_OUTPUT, nil
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__encoding.html#ga5c1d07c778698f0c9dd3b8ad2f0f46b6
+//
+// Frame is cloned before being passed to libavformat therefore caller keeps ownership of it
+func (fc *FormatContext) WriteUncodedFrame(streamIndex int, f *Frame) error {
+	if f == nil {
+		return errors.New("astiav: frame must not be nil")
+	}
+	cf := C.av_frame_clone(f.c)
+	if cf == nil {
+		return errors.New("astiav: cloning frame failed")
+	}
+	return newError(C.av_write_uncoded_frame(fc.c, C.int(streamIndex), cf))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__encoding.html#ga504f87e288e76ac4f72f3dbb7e35ec5f
+//
+// Frame is cloned before being passed to libavformat therefore caller keeps ownership of it. A nil
+// frame flushes the interleaving queues.
+func (fc *FormatContext) WriteInterleavedUncodedFrame(streamIndex int, f *Frame) error {
+	var cf *C.AVFrame
+	if f != nil {
+		if cf = C.av_frame_clone(f.c); cf == nil {
+			return errors.New("astiav: cloning frame failed")
+		}
+	}
+	return newError(C.av_interleaved_write_uncoded_frame(fc.c, C.int(streamIndex), cf))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__encoding.html#ga5f6bebdc8c234a5ad9740de89acb15e6
+func (fc *FormatContext) QueryWriteUncodedFrame(streamIndex int) error {
+	return newError(C.av_write_uncoded_frame_query(fc.c, C.int(streamIndex)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__encoding.html#ga661ee0b2f3dbcaaef5d68f2f5d75e2d4
+func (fc *FormatContext) OutputTimestamp(streamIndex int) (dts, wall int64, err error) {
+	var cdts, cwall C.int64_t
+	if err = newError(C.av_get_output_timestamp(fc.c, C.int(streamIndex), &cdts, &cwall)); err != nil {
+		return
+	}
+	dts, wall = int64(cdts), int64(cwall)
+	return
+}
+
 // https://ffmpeg.org/doxygen/8.0/group__lavf__misc.html#gafa6fbfe5c1bf6792fd6e33475b6056bd
 func (fc *FormatContext) GuessSampleAspectRatio(s *Stream, f *Frame) Rational {
 	var cf *C.AVFrame
@@ -376,6 +671,31 @@ func (fc *FormatContext) GuessSampleAspectRatio(s *Stream, f *Frame) Rational {
 	return newRationalFromC(C.av_guess_sample_aspect_ratio(fc.c, s.c, cf))
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__lavf__misc.html#ga7e45597834e9ef3098ddb74bc5e1550c
+func (fc *FormatContext) MatchStreamSpecifier(s *Stream, spec string) (bool, error) {
+	specc := C.CString(spec)
+	defer C.free(unsafe.Pointer(specc))
+	ret := C.avformat_match_stream_specifier(fc.c, s.c, specc)
+	if err := newError(ret); err != nil {
+		return false, err
+	}
+	return ret > 0, nil
+}
+
+// Returns all streams matching the stream specifier
+func (fc *FormatContext) MatchStreams(spec string) (ss []*Stream, err error) {
+	for _, s := range fc.Streams() {
+		var ok bool
+		if ok, err = fc.MatchStreamSpecifier(s, spec); err != nil {
+			err = fmt.Errorf("astiav: matching stream specifier %q failed: %w", spec, err)
+			return
+		} else if ok {
+			ss = append(ss, s)
+		}
+	}
+	return
+}
+
 // https://ffmpeg.org/doxygen/8.0/group__lavf__misc.html#ga698e6aa73caa9616851092e2be15875d
 func (fc *FormatContext) GuessFrameRate(s *Stream, f *Frame) Rational {
 	var cf *C.AVFrame
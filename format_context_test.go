@@ -1,6 +1,7 @@
 package astiav
 
 import (
+	"math"
 	"path/filepath"
 	"testing"
 
@@ -55,6 +56,31 @@ func TestFormatContext(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, s2.Index())
 
+	ok, err := fc1.MatchStreamSpecifier(s1, "v")
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = fc1.MatchStreamSpecifier(s1, "a:0")
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, err = fc1.MatchStreamSpecifier(s1, "invalid")
+	require.Error(t, err)
+	ms, err := fc1.MatchStreams("a:0")
+	require.NoError(t, err)
+	require.Len(t, ms, 1)
+	require.Equal(t, 1, ms[0].Index())
+	ms, err = fc1.MatchStreams("#0x2")
+	require.NoError(t, err)
+	require.Len(t, ms, 1)
+	require.Equal(t, 1, ms[0].Index())
+	ms, err = fc1.MatchStreams("m:language:und")
+	require.NoError(t, err)
+	require.Len(t, ms, 2)
+	ms, err = fc1.MatchStreams("s")
+	require.NoError(t, err)
+	require.Len(t, ms, 0)
+	_, err = fc1.MatchStreams("invalid")
+	require.Error(t, err)
+
 	fc2, err := AllocOutputFormatContext(nil, "mp4", "")
 	require.NoError(t, err)
 	defer fc2.Free()
@@ -72,6 +98,38 @@ func TestFormatContext(t *testing.T) {
 	require.NotNil(t, fc3.Pb())
 	require.Equal(t, StrictStdComplianceExperimental, fc3.StrictStdCompliance())
 	require.True(t, fc3.Flags().Has(FormatContextFlagAutoBsf))
+	require.Equal(t, AvoidNegativeTsAuto, fc3.AvoidNegativeTs())
+	fc3.SetAvoidNegativeTs(AvoidNegativeTsMakeZero)
+	require.Equal(t, AvoidNegativeTsMakeZero, fc3.AvoidNegativeTs())
+	require.Equal(t, 1<<20, fc3.FormatProbeSize())
+	fc3.SetFormatProbeSize(1024)
+	require.Equal(t, 1024, fc3.FormatProbeSize())
+	require.Equal(t, -1, fc3.FpsProbeSize())
+	fc3.SetFpsProbeSize(2)
+	require.Equal(t, 2, fc3.FpsProbeSize())
+	fc3.SetMaxAnalyzeDuration(3)
+	require.Equal(t, int64(3), fc3.MaxAnalyzeDuration())
+	require.Equal(t, -1, fc3.MaxDelay())
+	fc3.SetMaxDelay(4)
+	require.Equal(t, 4, fc3.MaxDelay())
+	require.Equal(t, int64(10000000), fc3.MaxInterleaveDelta())
+	fc3.SetMaxInterleaveDelta(5)
+	require.Equal(t, int64(5), fc3.MaxInterleaveDelta())
+	require.Equal(t, 1000, fc3.MaxStreams())
+	fc3.SetMaxStreams(6)
+	require.Equal(t, 6, fc3.MaxStreams())
+	require.Equal(t, -1, fc3.MetadataHeaderPadding())
+	fc3.SetMetadataHeaderPadding(7)
+	require.Equal(t, 7, fc3.MetadataHeaderPadding())
+	require.Equal(t, int64(0), fc3.OutputTsOffset())
+	fc3.SetOutputTsOffset(8)
+	require.Equal(t, int64(8), fc3.OutputTsOffset())
+	require.Equal(t, int64(5000000), fc3.ProbeSize())
+	fc3.SetProbeSize(9)
+	require.Equal(t, int64(9), fc3.ProbeSize())
+	require.Equal(t, NoPtsValue, fc3.StartTimeRealtime())
+	fc3.SetStartTimeRealtime(10)
+	require.Equal(t, int64(10), fc3.StartTimeRealtime())
 	s3 := fc3.NewStream(nil)
 	require.NotNil(t, s3)
 	s4 := fc3.NewStream(nil)
@@ -176,4 +234,86 @@ func TestFormatContext(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 2, fc10.NbChapters())
 	require.Len(t, fc10.Chapters(), 2)
+
+	fc11 := AllocFormatContext()
+	require.NotNil(t, fc11)
+	defer fc11.Free()
+	require.NoError(t, fc11.OpenInput("testdata/video.mp4", nil, nil))
+	defer fc11.CloseInput()
+	require.NoError(t, fc11.FindStreamInfo(nil))
+	s5 := fc11.Streams()[0]
+	pkt5 := AllocPacket()
+	require.NotNil(t, pkt5)
+	defer pkt5.Free()
+	var pts int64
+	for {
+		require.NoError(t, fc11.ReadFrame(pkt5))
+		if pkt5.StreamIndex() == s5.Index() && !pkt5.Flags().Has(PacketFlagKey) && pkt5.Pts() > int64(s5.TimeBase().Den()) {
+			pts = pkt5.Pts()
+			pkt5.Unref()
+			break
+		}
+		pkt5.Unref()
+	}
+	require.NoError(t, fc11.SeekFile(-1, math.MinInt64, 0, 0, 0))
+	require.NoError(t, fc11.ReadFrame(pkt5))
+	require.Equal(t, int64(48), pkt5.Pos())
+	pkt5.Unref()
+	c2 := FindDecoder(s5.CodecParameters().CodecID())
+	require.NotNil(t, c2)
+	cc1 := AllocCodecContext(c2)
+	require.NotNil(t, cc1)
+	defer cc1.Free()
+	require.NoError(t, s5.CodecParameters().ToCodecContext(cc1))
+	require.NoError(t, cc1.Open(c2, nil))
+	f1 := AllocFrame()
+	require.NotNil(t, f1)
+	defer f1.Free()
+	require.NoError(t, fc11.SeekExactFrame(s5.Index(), pts, cc1, f1))
+	require.Equal(t, pts, f1.Pts())
+
+	outputPath = filepath.Join(t.TempDir(), "test-format-context-init-output.mp4")
+	fc12, err := AllocOutputFormatContext(nil, "", outputPath)
+	require.NoError(t, err)
+	defer fc12.Free()
+	for _, is := range fc11.Streams() {
+		os := fc12.NewStream(nil)
+		require.NotNil(t, os)
+		require.NoError(t, is.CodecParameters().Copy(os.CodecParameters()))
+	}
+	ic2, err := OpenIOContext(outputPath, NewIOContextFlags(IOContextFlagWrite), nil, nil)
+	require.NoError(t, err)
+	defer ic2.Free()
+	defer ic2.Close()
+	fc12.SetPb(ic2)
+	_, err = fc12.InitOutput(nil)
+	require.NoError(t, err)
+	require.Error(t, fc12.QueryWriteUncodedFrame(0))
+	require.Error(t, fc12.WriteUncodedFrame(0, f1))
+	require.Error(t, fc12.WriteUncodedFrame(0, nil))
+	_, _, err = fc12.OutputTimestamp(0)
+	require.Error(t, err)
+	require.NoError(t, fc12.WriteHeader(nil))
+	require.NoError(t, fc12.WriteTrailer())
+
+	fc13 := AllocFormatContext()
+	require.NotNil(t, fc13)
+	defer fc13.Free()
+	require.NoError(t, fc13.OpenInput("testdata/video.mp4", nil, nil))
+	defer fc13.CloseInput()
+	require.Error(t, fc13.Pause())
+	require.Error(t, fc13.Play())
+	pkt6 := AllocPacket()
+	require.NotNil(t, pkt6)
+	defer pkt6.Free()
+	start := RelativeTime()
+	for {
+		require.NoError(t, fc13.ReadFrameRealtime(pkt6))
+		ts := RescaleQ(pkt6.Dts(), fc13.Streams()[pkt6.StreamIndex()].TimeBase(), TimeBaseQ)
+		pkt6.Unref()
+		if ts >= 200000 {
+			break
+		}
+	}
+	require.GreaterOrEqual(t, RelativeTime()-start, int64(150000))
 }
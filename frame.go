@@ -8,6 +8,7 @@ package astiav
 //#include "frame.h"
 import "C"
 import (
+	"fmt"
 	"unsafe"
 )
 
@@ -180,6 +181,18 @@ func (f *Frame) SetPictureType(t PictureType) {
 	f.c.pict_type = C.enum_AVPictureType(t)
 }
 
+// SetForcedKeyFrame makes encoders output a keyframe for this frame when forced is true, and lets them
+// decide otherwise. It updates both the picture type and FrameFlagKey.
+func (f *Frame) SetForcedKeyFrame(forced bool) {
+	if forced {
+		f.c.pict_type = C.AV_PICTURE_TYPE_I
+		f.c.flags |= C.AV_FRAME_FLAG_KEY
+	} else {
+		f.c.pict_type = C.AV_PICTURE_TYPE_NONE
+		f.c.flags &^= C.AV_FRAME_FLAG_KEY
+	}
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVFrame.html#aed14fa772ce46881020fd1545c86432c
 func (f *Frame) PixelFormat() PixelFormat {
 	return PixelFormat(f.c.format)
@@ -190,6 +203,24 @@ func (f *Frame) SetPixelFormat(pf PixelFormat) {
 	f.c.format = C.int(pf)
 }
 
+// SetQuantisationOffset replaces the frame's regions of interest with a single region covering the whole
+// frame, so that encoders supporting them apply the quantisation offset to the entire frame. The offset
+// must be in [-1, 1], -1 being the best quality, and the frame's dimensions must be set beforehand.
+func (f *Frame) SetQuantisationOffset(q Rational) error {
+	if q.Den() == 0 || q.Float64() < -1 || q.Float64() > 1 {
+		return fmt.Errorf("astiav: invalid quantisation offset %s, must be in [-1, 1]", q)
+	}
+	if f.Width() <= 0 || f.Height() <= 0 {
+		return fmt.Errorf("astiav: invalid frame dimensions %dx%d", f.Width(), f.Height())
+	}
+	C.av_frame_remove_side_data(f.c, C.AV_FRAME_DATA_REGIONS_OF_INTEREST)
+	return f.SideData().RegionsOfInterest().Add([]RegionOfInterest{{
+		Bottom:             f.Height(),
+		QuantisationOffset: q,
+		Right:              f.Width(),
+	}})
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVFrame.html#aa52951f35ec9e303d3dfeb4b3e44248a
 func (f *Frame) PktDts() int64 {
 	return int64(f.c.pkt_dts)
@@ -269,6 +300,11 @@ func (f *Frame) TransferHardwareData(dst *Frame) error {
 	return newError(C.av_hwframe_transfer_data(dst.c, f.c, 0))
 }
 
+// https://ffmpeg.org/doxygen/8.0/hwcontext_8c.html#a99e028453fba7e66483c2189439219d2
+func (f *Frame) MapHardwareData(dst *Frame, fs HardwareFrameMapFlags) error {
+	return newError(C.av_hwframe_map(dst.c, f.c, C.int(fs)))
+}
+
 // https://ffmpeg.org/doxygen/8.0/group__lavu__frame.html#ga979d73f3228814aee56aeca0636e37cc
 func (f *Frame) Free() {
 	if f.c != nil {
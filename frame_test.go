@@ -48,6 +48,24 @@ func TestFrame(t *testing.T) {
 	require.Equal(t, NewRational(10, 2), f2.SampleAspectRatio())
 	require.Equal(t, 9, f2.SampleRate())
 	require.Equal(t, 10, f2.Width())
+	f2.SetForcedKeyFrame(false)
+	require.False(t, f2.Flags().Has(FrameFlagKey))
+	require.Equal(t, PictureTypeNone, f2.PictureType())
+	f2.SetForcedKeyFrame(true)
+	require.True(t, f2.Flags().Has(FrameFlagKey))
+	require.Equal(t, PictureTypeI, f2.PictureType())
+	require.Error(t, f2.SetQuantisationOffset(NewRational(3, 2)))
+	require.Error(t, f2.SetQuantisationOffset(NewRational(1, 0)))
+	require.NoError(t, f2.SetQuantisationOffset(NewRational(-1, 10)))
+	require.NoError(t, f2.SetQuantisationOffset(NewRational(-1, 5)))
+	rois, ok := f2.SideData().RegionsOfInterest().Get()
+	require.True(t, ok)
+	require.Equal(t, []RegionOfInterest{{Bottom: 2, QuantisationOffset: NewRational(-1, 5), Right: 10}}, rois)
+	mf := AllocFrame()
+	require.NotNil(t, mf)
+	defer mf.Free()
+	require.Error(t, mf.SetQuantisationOffset(NewRational(-1, 5)))
+	require.ErrorIs(t, f2.MapHardwareData(mf, NewHardwareFrameMapFlags(HardwareFrameMapFlagRead)), ErrEnosys)
 
 	f3 := f1.Clone()
 	require.NotNil(t, f3)
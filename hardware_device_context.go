@@ -4,6 +4,7 @@ package astiav
 //#include <libavutil/hwcontext.h>
 import "C"
 import (
+	"errors"
 	"unsafe"
 )
 
@@ -12,6 +13,13 @@ type HardwareDeviceContext struct {
 	c *C.AVBufferRef
 }
 
+func newHardwareDeviceContextFromC(c *C.AVBufferRef) *HardwareDeviceContext {
+	if c == nil {
+		return nil
+	}
+	return &HardwareDeviceContext{c: c}
+}
+
 // https://ffmpeg.org/doxygen/8.0/hwcontext_8c.html#a21fbd088225e4e25c4d9a01b3f5e8c51
 func CreateHardwareDeviceContext(t HardwareDeviceType, device string, options *Dictionary, flags int) (*HardwareDeviceContext, error) {
 	hdc := HardwareDeviceContext{}
@@ -30,6 +38,22 @@ func CreateHardwareDeviceContext(t HardwareDeviceType, device string, options *D
 	return &hdc, nil
 }
 
+// https://ffmpeg.org/doxygen/8.0/hwcontext_8c.html#ae365870d39607b16736b51f24f74a749
+func CreateDerivedHardwareDeviceContext(t HardwareDeviceType, src *HardwareDeviceContext, options *Dictionary, flags int) (*HardwareDeviceContext, error) {
+	if src == nil {
+		return nil, errors.New("astiav: source hardware device context must not be nil")
+	}
+	hdc := HardwareDeviceContext{}
+	optionsC := (*C.AVDictionary)(nil)
+	if options != nil {
+		optionsC = options.c
+	}
+	if err := newError(C.av_hwdevice_ctx_create_derived_opts(&hdc.c, (C.enum_AVHWDeviceType)(t), src.c, optionsC, C.int(flags))); err != nil {
+		return nil, err
+	}
+	return &hdc, nil
+}
+
 // https://ffmpeg.org/doxygen/8.0/hwcontext_8c.html#a80f4c1184e1758150b6d9bc0adf2c1df
 func (hdc *HardwareDeviceContext) HardwareFramesConstraints() *HardwareFramesConstraints {
 	return newHardwareFramesConstraintsFromC(C.av_hwdevice_get_hwframe_constraints(hdc.c, nil))
@@ -0,0 +1,85 @@
+package astiav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHardwareDeviceContext(t *testing.T) {
+	_, err := CreateDerivedHardwareDeviceContext(HardwareDeviceTypeOpenCL, nil, nil, 0)
+	require.Error(t, err)
+	_, err = CreateDerivedHardwareFramesContext(PixelFormatOpencl, nil, nil, NewHardwareFrameMapFlags())
+	require.Error(t, err)
+
+	cc := AllocCodecContext(nil)
+	require.NotNil(t, cc)
+	defer cc.Free()
+	_, err = cc.HardwareFramesParameters(nil, PixelFormatVaapi)
+	require.Error(t, err)
+}
+
+func TestHardwareDeviceContextDerivation(t *testing.T) {
+	// Create the first available hardware device
+	var hdc *HardwareDeviceContext
+	var ht HardwareDeviceType
+	for _, v := range HardwareDeviceTypes() {
+		var err error
+		if hdc, err = CreateHardwareDeviceContext(v, "", nil, 0); err == nil {
+			ht = v
+			break
+		}
+	}
+	if hdc == nil {
+		t.Skip("no hardware device is available")
+	}
+	defer hdc.Free()
+
+	// Deriving to the same type returns a reference to the source device
+	dhdc, err := CreateDerivedHardwareDeviceContext(ht, hdc, nil, 0)
+	require.NoError(t, err)
+	dhdc.Free()
+
+	// Deriving to a type that can't be derived from another device returns ENOSYS
+	for _, v := range HardwareDeviceTypes() {
+		switch v {
+		case HardwareDeviceTypeD3D11VA, HardwareDeviceTypeDRM, HardwareDeviceTypeDXVA2, HardwareDeviceTypeMediaCodec, HardwareDeviceTypeVDPAU, HardwareDeviceTypeVideoToolbox:
+			if v == ht {
+				continue
+			}
+			_, err = CreateDerivedHardwareDeviceContext(v, hdc, nil, 0)
+			require.ErrorIs(t, err, ErrEnosys)
+		}
+	}
+
+	// Create frames context
+	hfcs := hdc.HardwareFramesConstraints()
+	require.NotNil(t, hfcs)
+	defer hfcs.Free()
+	hpfs := hfcs.ValidHardwarePixelFormats()
+	require.NotEmpty(t, hpfs)
+	spfs := hfcs.ValidSoftwarePixelFormats()
+	require.NotEmpty(t, spfs)
+	hfc := AllocHardwareFramesContext(hdc)
+	require.NotNil(t, hfc)
+	defer hfc.Free()
+	hfc.SetHardwarePixelFormat(hpfs[0])
+	hfc.SetSoftwarePixelFormat(spfs[0])
+	hfc.SetWidth(64)
+	hfc.SetHeight(64)
+	require.NoError(t, hfc.Initialize())
+
+	// Get transfer formats
+	pfs, err := hfc.TransferFormats(HardwareFrameTransferDirectionFrom)
+	require.NoError(t, err)
+	require.NotEmpty(t, pfs)
+	pfs, err = hfc.TransferFormats(HardwareFrameTransferDirectionTo)
+	require.NoError(t, err)
+	require.NotEmpty(t, pfs)
+
+	// Get device
+	hfcd := hfc.HardwareDeviceContext()
+	require.NotNil(t, hfcd)
+	hfcd.Free()
+	require.Equal(t, 64, hfc.Width())
+}
@@ -39,3 +39,15 @@ func FindHardwareDeviceTypeByName(n string) HardwareDeviceType {
 	defer C.free(unsafe.Pointer(cn))
 	return HardwareDeviceType(C.av_hwdevice_find_type_by_name(cn))
 }
+
+// https://ffmpeg.org/doxygen/8.0/hwcontext_8c.html#a0fb42d664a6ec87f8802e9132b85cac3
+func HardwareDeviceTypes() (ts []HardwareDeviceType) {
+	t := C.enum_AVHWDeviceType(C.AV_HWDEVICE_TYPE_NONE)
+	for {
+		if t = C.av_hwdevice_iterate_types(t); t == C.AV_HWDEVICE_TYPE_NONE {
+			break
+		}
+		ts = append(ts, HardwareDeviceType(t))
+	}
+	return
+}
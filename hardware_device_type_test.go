@@ -10,4 +10,8 @@ func TestHardwareDeviceType(t *testing.T) {
 	require.Equal(t, "cuda", HardwareDeviceTypeCUDA.Name())
 	require.Equal(t, "cuda", HardwareDeviceTypeCUDA.String())
 	require.Equal(t, FindHardwareDeviceTypeByName("cuda"), HardwareDeviceTypeCUDA)
+	for _, ht := range HardwareDeviceTypes() {
+		require.NotEqual(t, HardwareDeviceTypeNone, ht)
+		require.Equal(t, ht, FindHardwareDeviceTypeByName(ht.Name()))
+	}
 }
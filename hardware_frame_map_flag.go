@@ -0,0 +1,14 @@
+package astiav
+
+//#include <libavutil/hwcontext.h>
+import "C"
+
+// https://ffmpeg.org/doxygen/8.0/hwcontext_8h.html
+type HardwareFrameMapFlag int64
+
+const (
+	HardwareFrameMapFlagDirect    = HardwareFrameMapFlag(C.AV_HWFRAME_MAP_DIRECT)
+	HardwareFrameMapFlagOverwrite = HardwareFrameMapFlag(C.AV_HWFRAME_MAP_OVERWRITE)
+	HardwareFrameMapFlagRead      = HardwareFrameMapFlag(C.AV_HWFRAME_MAP_READ)
+	HardwareFrameMapFlagWrite     = HardwareFrameMapFlag(C.AV_HWFRAME_MAP_WRITE)
+)
@@ -0,0 +1,12 @@
+package astiav
+
+//#include <libavutil/hwcontext.h>
+import "C"
+
+// https://ffmpeg.org/doxygen/8.0/hwcontext_8h.html
+type HardwareFrameTransferDirection C.enum_AVHWFrameTransferDirection
+
+const (
+	HardwareFrameTransferDirectionFrom = HardwareFrameTransferDirection(C.AV_HWFRAME_TRANSFER_DIRECTION_FROM)
+	HardwareFrameTransferDirectionTo   = HardwareFrameTransferDirection(C.AV_HWFRAME_TRANSFER_DIRECTION_TO)
+)
@@ -1,8 +1,10 @@
 package astiav
 
 //#include <libavcodec/avcodec.h>
+//#include <libavutil/hwcontext.h>
 import "C"
 import (
+	"errors"
 	"unsafe"
 )
 
@@ -23,6 +25,18 @@ func AllocHardwareFramesContext(hdc *HardwareDeviceContext) *HardwareFramesConte
 	return newHardwareFramesContextFromC(C.av_hwframe_ctx_alloc(hdc.c))
 }
 
+// https://ffmpeg.org/doxygen/8.0/hwcontext_8c.html#a5228c42445cffd10087f0590614edb13
+func CreateDerivedHardwareFramesContext(pf PixelFormat, derivedDevice *HardwareDeviceContext, src *HardwareFramesContext, f HardwareFrameMapFlags) (*HardwareFramesContext, error) {
+	if derivedDevice == nil || src == nil {
+		return nil, errors.New("astiav: hardware device and frames contexts must not be nil")
+	}
+	var c *C.AVBufferRef
+	if err := newError(C.av_hwframe_ctx_create_derived(&c, C.enum_AVPixelFormat(pf), derivedDevice.c, src.c, C.int(f))); err != nil {
+		return nil, err
+	}
+	return newHardwareFramesContextFromC(c), nil
+}
+
 func (hfc *HardwareFramesContext) Free() {
 	if hfc.c != nil {
 		C.av_buffer_unref(&hfc.c)
@@ -33,26 +47,67 @@ func (hfc *HardwareFramesContext) data() *C.AVHWFramesContext {
 	return (*C.AVHWFramesContext)(unsafe.Pointer((hfc.c.data)))
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#a2f3908bfadd569103b67ee4972ee7305
+//
+// Returned hardware device context holds its own reference and must be freed by the caller
+func (hfc *HardwareFramesContext) HardwareDeviceContext() *HardwareDeviceContext {
+	ref := hfc.data().device_ref
+	if ref == nil {
+		return nil
+	}
+	return newHardwareDeviceContextFromC(C.av_buffer_ref(ref))
+}
+
+// https://ffmpeg.org/doxygen/8.0/hwcontext_8c.html#a80f4c1184e1758150b6d9bc0adf2c1df
+func (hfc *HardwareFramesContext) HardwareFramesConstraints() *HardwareFramesConstraints {
+	return newHardwareFramesConstraintsFromC(C.av_hwdevice_get_hwframe_constraints(hfc.data().device_ref, nil))
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#a9e6f29d0f744930cdd0e8bdff8771520
+func (hfc *HardwareFramesContext) Width() int {
+	return int(hfc.data().width)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#a9e6f29d0f744930cdd0e8bdff8771520
 func (hfc *HardwareFramesContext) SetWidth(width int) {
 	hfc.data().width = C.int(width)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#ae61bbe1d8645a0c573085e29f1d0a58f
+func (hfc *HardwareFramesContext) Height() int {
+	return int(hfc.data().height)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#ae61bbe1d8645a0c573085e29f1d0a58f
 func (hfc *HardwareFramesContext) SetHeight(height int) {
 	hfc.data().height = C.int(height)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#a045bc1713932804f6ceef170a5578e0e
+func (hfc *HardwareFramesContext) HardwarePixelFormat() PixelFormat {
+	return PixelFormat(hfc.data().format)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#a045bc1713932804f6ceef170a5578e0e
 func (hfc *HardwareFramesContext) SetHardwarePixelFormat(format PixelFormat) {
 	hfc.data().format = C.enum_AVPixelFormat(format)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#a663a9aceca97aa7b2426c9aba6543e4a
+func (hfc *HardwareFramesContext) SoftwarePixelFormat() PixelFormat {
+	return PixelFormat(hfc.data().sw_format)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#a663a9aceca97aa7b2426c9aba6543e4a
 func (hfc *HardwareFramesContext) SetSoftwarePixelFormat(swFormat PixelFormat) {
 	hfc.data().sw_format = C.enum_AVPixelFormat(swFormat)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#a9c3a94dcd9c96e19059b56a6bae9c764
+func (hfc *HardwareFramesContext) InitialPoolSize() int {
+	return int(hfc.data().initial_pool_size)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVHWFramesContext.html#a9c3a94dcd9c96e19059b56a6bae9c764
 func (hfc *HardwareFramesContext) SetInitialPoolSize(initialPoolSize int) {
 	hfc.data().initial_pool_size = C.int(initialPoolSize)
@@ -62,3 +117,21 @@ func (hfc *HardwareFramesContext) SetInitialPoolSize(initialPoolSize int) {
 func (hfc *HardwareFramesContext) Initialize() error {
 	return newError(C.av_hwframe_ctx_init(hfc.c))
 }
+
+// https://ffmpeg.org/doxygen/8.0/hwcontext_8c.html#a2467bc6d78356f81f74d9acf6cc1f2d7
+func (hfc *HardwareFramesContext) TransferFormats(d HardwareFrameTransferDirection) ([]PixelFormat, error) {
+	var formats *C.enum_AVPixelFormat
+	if err := newError(C.av_hwframe_transfer_get_formats(hfc.c, C.enum_AVHWFrameTransferDirection(d), &formats, 0)); err != nil {
+		return nil, err
+	}
+	defer C.av_free(unsafe.Pointer(formats))
+	var pfs []PixelFormat
+	for i := uintptr(0); ; i++ {
+		pf := *(*C.enum_AVPixelFormat)(unsafe.Pointer(uintptr(unsafe.Pointer(formats)) + i*unsafe.Sizeof(*formats)))
+		if pf == C.AV_PIX_FMT_NONE {
+			break
+		}
+		pfs = append(pfs, PixelFormat(pf))
+	}
+	return pfs, nil
+}
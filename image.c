@@ -0,0 +1,9 @@
+#include <libavutil/imgutils.h>
+#include <libavutil/pixfmt.h>
+#include <stdint.h>
+
+int astiavImageCopyToBuffer(uint8_t *dst, int dst_size, const uint8_t *src_data0, const uint8_t *src_data1, const uint8_t *src_data2, const uint8_t *src_data3, const int src_linesize[4], enum AVPixelFormat pix_fmt, int width, int height, int align)
+{
+	const uint8_t *src_data[4] = {src_data0, src_data1, src_data2, src_data3};
+	return av_image_copy_to_buffer(dst, dst_size, src_data, src_linesize, pix_fmt, width, height, align);
+}
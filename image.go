@@ -0,0 +1,169 @@
+package astiav
+
+//#include <libavutil/imgutils.h>
+//#include "image.h"
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__picture.html#ga8eddd074d5eb6a235591013675ac1055
+func ImageLinesize(pf PixelFormat, width, plane int) (int, error) {
+	ret := C.av_image_get_linesize(C.enum_AVPixelFormat(pf), C.int(width), C.int(plane))
+	if err := newError(ret); err != nil {
+		return 0, err
+	}
+	return int(ret), nil
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__picture.html#gaccd7fda79188060dadb28408223946ef
+func ImageFillLinesizes(pf PixelFormat, width int) ([4]int, error) {
+	var cLinesizes [4]C.int
+	if err := newError(C.av_image_fill_linesizes(&cLinesizes[0], C.enum_AVPixelFormat(pf), C.int(width))); err != nil {
+		return [4]int{}, err
+	}
+	var linesizes [4]int
+	for i, v := range cLinesizes {
+		linesizes[i] = int(v)
+	}
+	return linesizes, nil
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__picture.html#ga58cffc1b2c32f1f186144e81176128eb
+func ImageFillPlaneSizes(pf PixelFormat, height int, linesizes [4]int) ([4]int, error) {
+	var cLinesizes [4]C.ptrdiff_t
+	for i, v := range linesizes {
+		cLinesizes[i] = C.ptrdiff_t(v)
+	}
+	var cSizes [4]C.size_t
+	if err := newError(C.av_image_fill_plane_sizes(&cSizes[0], C.enum_AVPixelFormat(pf), C.int(height), &cLinesizes[0])); err != nil {
+		return [4]int{}, err
+	}
+	var sizes [4]int
+	for i, v := range cSizes {
+		sizes[i] = int(v)
+	}
+	return sizes, nil
+}
+
+// Splits the buffer into planes the same way av_image_fill_pointers() does
+//
+// https://ffmpeg.org/doxygen/8.0/group__lavu__picture.html#ga4513d36f527c1476e89845631d975542
+func ImageFillPointers(b []byte, pf PixelFormat, height int, linesizes [4]int) ([4][]byte, error) {
+	// Get plane sizes
+	sizes, err := ImageFillPlaneSizes(pf, height, linesizes)
+	if err != nil {
+		return [4][]byte{}, fmt.Errorf("astiav: getting plane sizes failed: %w", err)
+	}
+
+	// Check buffer size
+	var size int
+	for _, s := range sizes {
+		size += s
+	}
+	if len(b) < size {
+		return [4][]byte{}, fmt.Errorf("astiav: invalid buffer length %d < %d", len(b), size)
+	}
+
+	// Split buffer
+	var planes [4][]byte
+	start := 0
+	for i, s := range sizes {
+		if s == 0 {
+			continue
+		}
+		planes[i] = b[start : start+s : start+s]
+		start += s
+	}
+	return planes, nil
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__picture.html#ga24a67963c3ae0054a2a4bab35930e694
+func ImageBufferSize(pf PixelFormat, width, height, align int) (int, error) {
+	ret := C.av_image_get_buffer_size(C.enum_AVPixelFormat(pf), C.int(width), C.int(height), C.int(align))
+	if err := newError(ret); err != nil {
+		return 0, err
+	}
+	return int(ret), nil
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__picture.html#ga735cb949320867e9c2fffcb327d0ece2
+func ImageCopyPlane(dst []byte, dstLinesize int, src []byte, srcLinesize int, bytewidth, height int) error {
+	// Nothing to copy
+	if bytewidth <= 0 || height <= 0 {
+		return nil
+	}
+
+	// Check sizes
+	if dstLinesize < bytewidth || srcLinesize < bytewidth {
+		return fmt.Errorf("astiav: invalid linesizes %d and %d < %d", dstLinesize, srcLinesize, bytewidth)
+	}
+	if s := dstLinesize*(height-1) + bytewidth; len(dst) < s {
+		return fmt.Errorf("astiav: invalid dst length %d < %d", len(dst), s)
+	}
+	if s := srcLinesize*(height-1) + bytewidth; len(src) < s {
+		return fmt.Errorf("astiav: invalid src length %d < %d", len(src), s)
+	}
+
+	// Copy
+	C.av_image_copy_plane((*C.uint8_t)(unsafe.Pointer(&dst[0])), C.int(dstLinesize), (*C.uint8_t)(unsafe.Pointer(&src[0])), C.int(srcLinesize), C.int(bytewidth), C.int(height))
+	return nil
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__picture.html#ga6f8576f1ef0c2d9a9f7c5ac7f9a28c52
+func ImageCopyToBuffer(dst []byte, src [4][]byte, srcLinesizes [4]int, pf PixelFormat, width, height, align int) (int, error) {
+	// Check linesizes since av_image_copy_to_buffer() reads as many bytes per row as the linesizes
+	// derived from the width
+	linesizes, err := ImageFillLinesizes(pf, width)
+	if err != nil {
+		return 0, fmt.Errorf("astiav: getting linesizes failed: %w", err)
+	}
+	for i, l := range linesizes {
+		if srcLinesizes[i] < l {
+			return 0, fmt.Errorf("astiav: invalid plane #%d linesize %d < %d", i, srcLinesizes[i], l)
+		}
+	}
+
+	// Check plane sizes
+	sizes, err := ImageFillPlaneSizes(pf, height, srcLinesizes)
+	if err != nil {
+		return 0, fmt.Errorf("astiav: getting plane sizes failed: %w", err)
+	}
+	for i, s := range sizes {
+		if len(src[i]) < s {
+			return 0, fmt.Errorf("astiav: invalid plane #%d length %d < %d", i, len(src[i]), s)
+		}
+	}
+
+	// Get pointers
+	var cSrc [4]*C.uint8_t
+	var cSrcLinesizes [4]C.int
+	for i := range src {
+		if len(src[i]) > 0 {
+			cSrc[i] = (*C.uint8_t)(unsafe.Pointer(&src[i][0]))
+		}
+		cSrcLinesizes[i] = C.int(srcLinesizes[i])
+	}
+	var cDst *C.uint8_t
+	if len(dst) > 0 {
+		cDst = (*C.uint8_t)(unsafe.Pointer(&dst[0]))
+	}
+
+	// Copy
+	ret := C.astiavImageCopyToBuffer(cDst, C.int(len(dst)), cSrc[0], cSrc[1], cSrc[2], cSrc[3], &cSrcLinesizes[0], C.enum_AVPixelFormat(pf), C.int(width), C.int(height), C.int(align))
+	if err := newError(ret); err != nil {
+		return 0, err
+	}
+	return int(ret), nil
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__picture.html#ga3c5d7378556b4f68f47311558e8bf49d
+func ImageCheckSize(width, height int) error {
+	return newError(C.av_image_check_size(C.uint(width), C.uint(height), 0, nil))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__picture.html#gada0f86cd0051ff8b9eef214e83e7ad1f
+func ImageCheckSampleAspectRatio(width, height int, sar Rational) error {
+	return newError(C.av_image_check_sar(C.uint(width), C.uint(height), sar.c))
+}
@@ -0,0 +1,4 @@
+#include <libavutil/pixfmt.h>
+#include <stdint.h>
+
+int astiavImageCopyToBuffer(uint8_t *dst, int dst_size, const uint8_t *src_data0, const uint8_t *src_data1, const uint8_t *src_data2, const uint8_t *src_data3, const int src_linesize[4], enum AVPixelFormat pix_fmt, int width, int height, int align);
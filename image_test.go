@@ -0,0 +1,54 @@
+package astiav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImage(t *testing.T) {
+	l, err := ImageLinesize(PixelFormatYuv420P, 4, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, l)
+	_, err = ImageLinesize(PixelFormatNone, 4, 0)
+	require.Error(t, err)
+
+	ls, err := ImageFillLinesizes(PixelFormatYuv420P, 4)
+	require.NoError(t, err)
+	require.Equal(t, [4]int{4, 2, 2, 0}, ls)
+
+	ps, err := ImageFillPlaneSizes(PixelFormatYuv420P, 2, ls)
+	require.NoError(t, err)
+	require.Equal(t, [4]int{8, 2, 2, 0}, ps)
+
+	s, err := ImageBufferSize(PixelFormatYuv420P, 4, 2, 1)
+	require.NoError(t, err)
+	require.Equal(t, 12, s)
+
+	b1 := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	planes, err := ImageFillPointers(b1, PixelFormatYuv420P, 2, ls)
+	require.NoError(t, err)
+	require.Equal(t, [4][]byte{{0, 1, 2, 3, 4, 5, 6, 7}, {8, 9}, {10, 11}, nil}, planes)
+	_, err = ImageFillPointers(b1[:10], PixelFormatYuv420P, 2, ls)
+	require.Error(t, err)
+
+	b2 := make([]byte, 12)
+	n, err := ImageCopyToBuffer(b2, planes, ls, PixelFormatYuv420P, 4, 2, 1)
+	require.NoError(t, err)
+	require.Equal(t, 12, n)
+	require.Equal(t, b1, b2)
+	_, err = ImageCopyToBuffer(b2[:10], planes, ls, PixelFormatYuv420P, 4, 2, 1)
+	require.Error(t, err)
+	_, err = ImageCopyToBuffer(b2, [4][]byte{b1[:4], b1[4:5], b1[5:6], nil}, [4]int{2, 1, 1, 0}, PixelFormatYuv420P, 4, 2, 1)
+	require.Error(t, err)
+
+	b3 := make([]byte, 6)
+	require.NoError(t, ImageCopyPlane(b3, 3, b1, 4, 3, 2))
+	require.Equal(t, []byte{0, 1, 2, 4, 5, 6}, b3)
+	require.Error(t, ImageCopyPlane(b3, 3, b1, 4, 3, 3))
+
+	require.NoError(t, ImageCheckSize(4, 2))
+	require.Error(t, ImageCheckSize(0, 2))
+	require.NoError(t, ImageCheckSampleAspectRatio(4, 2, NewRational(1, 1)))
+	require.Error(t, ImageCheckSampleAspectRatio(4, 2, NewRational(-1, 1)))
+}
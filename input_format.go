@@ -1,8 +1,21 @@
 package astiav
 
 //#include <libavformat/avformat.h>
+//#include <string.h>
 import "C"
-import "unsafe"
+import (
+	"errors"
+	"unsafe"
+)
+
+const (
+	// https://ffmpeg.org/doxygen/8.0/group__libavf.html
+	ProbeScoreExtension = int(C.AVPROBE_SCORE_EXTENSION)
+	// https://ffmpeg.org/doxygen/8.0/group__libavf.html
+	ProbeScoreMax = int(C.AVPROBE_SCORE_MAX)
+	// https://ffmpeg.org/doxygen/8.0/group__libavf.html
+	ProbeScoreMime = int(C.AVPROBE_SCORE_MIME)
+)
 
 // https://ffmpeg.org/doxygen/8.0/structAVInputFormat.html
 type InputFormat struct {
@@ -41,3 +54,95 @@ func (f *InputFormat) LongName() string {
 func (f *InputFormat) String() string {
 	return f.Name()
 }
+
+// https://ffmpeg.org/doxygen/8.0/structAVInputFormat.html#ae692e27e532f664f26e2501967b09eab
+func (f *InputFormat) Extensions() string {
+	return C.GoString(f.c.extensions)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVInputFormat.html#a963cd77aa525517dcfdc18227dae788f
+func (f *InputFormat) MimeType() string {
+	return C.GoString(f.c.mime_type)
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__riff__fourcc.html#ga27b89fa8286af6efb5a69e8db4033b09
+func (f *InputFormat) CodecID(t CodecTag) CodecID {
+	if f.c.codec_tag == nil {
+		return CodecIDNone
+	}
+	return CodecID(C.av_codec_get_id(f.c.codec_tag, C.uint(t)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__core.html#gaddf8c11818a124e96109ad5eeed0d51b
+func InputFormats() (fs []*InputFormat) {
+	var opq *C.void = nil
+	for {
+		f := C.av_demuxer_iterate((*unsafe.Pointer)(unsafe.Pointer(&opq)))
+		if f == nil {
+			break
+		}
+		fs = append(fs, newInputFormatFromC(f))
+	}
+	return
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVProbeData.html
+type ProbeData struct {
+	Buf      []byte
+	Filename string
+	MimeType string
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__decoding.html#gadec1f64c5bb176492ab384d3ee4759ae
+func ProbeInputFormat(pd ProbeData, isOpened bool) (f *InputFormat, score int, err error) {
+	// Create probe data
+	var cpd C.AVProbeData
+
+	// Buffer needs to be padded with zeroes
+	if cpd.buf = (*C.uchar)(C.av_mallocz(C.size_t(len(pd.Buf) + C.AVPROBE_PADDING_SIZE))); cpd.buf == nil {
+		err = errors.New("astiav: allocating buffer failed")
+		return
+	}
+	defer C.av_free(unsafe.Pointer(cpd.buf))
+	if len(pd.Buf) > 0 {
+		C.memcpy(unsafe.Pointer(cpd.buf), unsafe.Pointer(&pd.Buf[0]), C.size_t(len(pd.Buf)))
+	}
+	cpd.buf_size = C.int(len(pd.Buf))
+
+	// Filename must not be nil
+	cpd.filename = C.CString(pd.Filename)
+	defer C.free(unsafe.Pointer(cpd.filename))
+
+	// Mime type
+	if pd.MimeType != "" {
+		cpd.mime_type = C.CString(pd.MimeType)
+		defer C.free(unsafe.Pointer(cpd.mime_type))
+	}
+
+	// Get is opened
+	o := C.int(0)
+	if isOpened {
+		o = C.int(1)
+	}
+
+	// Probe
+	var cscore C.int
+	f = newInputFormatFromC(C.av_probe_input_format3(&cpd, o, &cscore))
+	score = int(cscore)
+	return
+}
+
+// The probed data is kept in the io context's buffer which means the io context can be used afterwards
+// to open the input
+//
+// https://ffmpeg.org/doxygen/8.0/group__lavf__decoding.html#gaa71770b3d006daba1c300d505ff7ab9e
+func ProbeInputBuffer(ic *IOContext, url string, offset, maxProbeSize uint) (*InputFormat, int, error) {
+	curl := C.CString(url)
+	defer C.free(unsafe.Pointer(curl))
+	var cf *C.AVInputFormat
+	ret := C.av_probe_input_buffer2(ic.c, &cf, curl, nil, C.uint(offset), C.uint(maxProbeSize))
+	if err := newError(ret); err != nil {
+		return nil, 0, err
+	}
+	return newInputFormatFromC(cf), int(ret), nil
+}
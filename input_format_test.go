@@ -1,6 +1,7 @@
 package astiav
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,4 +14,31 @@ func TestInputFormat(t *testing.T) {
 	require.Equal(t, formatName, inputFormat.Name())
 	require.Equal(t, formatName, inputFormat.String())
 	require.Equal(t, "raw video", inputFormat.LongName())
+
+	wav := FindInputFormat("wav")
+	require.NotNil(t, wav)
+	require.Equal(t, CodecIDPcmS16Le, wav.CodecID(CodecTag(1)))
+
+	var names []string
+	for _, f := range InputFormats() {
+		names = append(names, f.Name())
+	}
+	require.Contains(t, names, "wav")
+
+	b, err := os.ReadFile("testdata/video.mp4")
+	require.NoError(t, err)
+	f, score, err := ProbeInputFormat(ProbeData{Buf: b[:2048]}, true)
+	require.NoError(t, err)
+	require.NotNil(t, f)
+	require.Equal(t, "mov,mp4,m4a,3gp,3g2,mj2", f.Name())
+	require.Equal(t, ProbeScoreMax, score)
+
+	ic, err := OpenIOContext("testdata/video.mp4", NewIOContextFlags(IOContextFlagRead), nil, nil)
+	require.NoError(t, err)
+	defer ic.Close() //nolint:errcheck
+	f, score, err = ProbeInputBuffer(ic, "", 0, 0)
+	require.NoError(t, err)
+	require.NotNil(t, f)
+	require.Equal(t, "mov,mp4,m4a,3gp,3g2,mj2", f.Name())
+	require.Equal(t, ProbeScoreMax, score)
 }
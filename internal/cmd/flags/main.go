@@ -16,8 +16,10 @@ type listItem struct {
 var list = []listItem{
 	{Name: "Buffersink"},
 	{Name: "Buffersrc"},
+	{Name: "CodecCapability"},
 	{Name: "CodecContext"},
 	{Name: "CodecContext", Suffix: "2"},
+	{Name: "CodecDescriptorProperty"},
 	{Name: "CodecHardwareConfigMethod"},
 	{Name: "Dictionary"},
 	{Name: "Disposition"},
@@ -28,11 +30,14 @@ var list = []listItem{
 	{Name: "FormatContextCtx"},
 	{Name: "FormatEvent"},
 	{Name: "Frame"},
+	{Name: "HardwareFrameMap"},
 	{Name: "IOContext"},
 	{Name: "IOFormat"},
+	{Name: "Option"},
 	{Name: "OptionSearch"},
 	{Name: "Packet"},
 	{Name: "PixelFormatDescriptor"},
+	{Name: "PixelFormatLoss"},
 	{Name: "Seek"},
 	{Name: "SoftwareScaleContext"},
 	{Name: "StreamEvent"},
@@ -0,0 +1,16 @@
+#include <libavutil/eval.h>
+#include "keyframe_scheduler.h"
+
+static const char *const astiavKeyframeSchedulerConstNames[] = {
+	"n",
+	"n_forced",
+	"prev_forced_n",
+	"prev_forced_t",
+	"t",
+	NULL,
+};
+
+int astiavKeyframeSchedulerParseExpr(AVExpr **expr, const char *s)
+{
+	return av_expr_parse(expr, s, astiavKeyframeSchedulerConstNames, NULL, NULL, NULL, NULL, 0, NULL);
+}
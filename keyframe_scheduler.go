@@ -0,0 +1,108 @@
+package astiav
+
+//#include <libavutil/eval.h>
+//#include <libavutil/parseutils.h>
+//#include <stdlib.h>
+//#include "keyframe_scheduler.h"
+import "C"
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+// KeyframeScheduler forces keyframes on frames before they are sent to an encoder, the same way
+// ffmpeg's -force_key_frames option does. Supported expressions are:
+//
+//   - "expr:<expr>" where <expr> is evaluated for each frame and can use the n, n_forced,
+//     prev_forced_n, prev_forced_t and t variables (e.g. "expr:gte(t,n_forced*2)")
+//   - "source" which keeps keyframes of the source frames
+//   - a comma separated list of times (e.g. "0,2.5,00:00:10")
+type KeyframeScheduler struct {
+	expr     *C.AVExpr
+	index    int
+	source   bool
+	times    []int64
+	timeBase Rational
+	values   [C.ASTIAV_KEYFRAME_SCHEDULER_NB]C.double
+}
+
+// Time base is the time base of the frames' pts
+func NewKeyframeScheduler(s string, timeBase Rational) (*KeyframeScheduler, error) {
+	ks := &KeyframeScheduler{timeBase: timeBase}
+	switch {
+	case strings.HasPrefix(s, "expr:"):
+		cs := C.CString(strings.TrimPrefix(s, "expr:"))
+		defer C.free(unsafe.Pointer(cs))
+		if err := newError(C.astiavKeyframeSchedulerParseExpr(&ks.expr, cs)); err != nil {
+			return nil, fmt.Errorf("astiav: parsing expression failed: %w", err)
+		}
+		ks.values[C.ASTIAV_KEYFRAME_SCHEDULER_PREV_FORCED_N] = C.double(math.NaN())
+		ks.values[C.ASTIAV_KEYFRAME_SCHEDULER_PREV_FORCED_T] = C.double(math.NaN())
+	case s == "source":
+		ks.source = true
+	default:
+		for _, v := range strings.Split(s, ",") {
+			cv := C.CString(strings.TrimSpace(v))
+			var t C.int64_t
+			err := newError(C.av_parse_time(&t, cv, 1))
+			C.free(unsafe.Pointer(cv))
+			if err != nil {
+				return nil, fmt.Errorf("astiav: parsing time %q failed: %w", v, err)
+			}
+			ks.times = append(ks.times, int64(t))
+		}
+		sort.Slice(ks.times, func(i, j int) bool { return ks.times[i] < ks.times[j] })
+	}
+	return ks, nil
+}
+
+func (ks *KeyframeScheduler) Free() {
+	if ks.expr != nil {
+		C.av_expr_free(ks.expr)
+		ks.expr = nil
+	}
+}
+
+// Apply forces a keyframe on the frame if it matches the expression, and lets the encoder decide
+// otherwise. It returns whether a keyframe has been forced.
+func (ks *KeyframeScheduler) Apply(f *Frame) bool {
+	forced := ks.forced(f)
+	f.SetForcedKeyFrame(forced)
+	return forced
+}
+
+func (ks *KeyframeScheduler) forced(f *Frame) bool {
+	switch {
+	case ks.expr != nil:
+		t := math.NaN()
+		if f.Pts() != NoPtsValue {
+			t = float64(f.Pts()) * ks.timeBase.Float64()
+		}
+		ks.values[C.ASTIAV_KEYFRAME_SCHEDULER_T] = C.double(t)
+		res := C.av_expr_eval(ks.expr, &ks.values[0], nil)
+		ks.values[C.ASTIAV_KEYFRAME_SCHEDULER_N] += 1
+		if res != 0 {
+			ks.values[C.ASTIAV_KEYFRAME_SCHEDULER_PREV_FORCED_N] = ks.values[C.ASTIAV_KEYFRAME_SCHEDULER_N] - 1
+			ks.values[C.ASTIAV_KEYFRAME_SCHEDULER_PREV_FORCED_T] = ks.values[C.ASTIAV_KEYFRAME_SCHEDULER_T]
+			ks.values[C.ASTIAV_KEYFRAME_SCHEDULER_N_FORCED] += 1
+			return true
+		}
+	case ks.source:
+		return f.Flags().Has(FrameFlagKey)
+	default:
+		if f.Pts() == NoPtsValue {
+			return false
+		}
+		// Skip all times the frame has reached so that a single keyframe is forced
+		var forced bool
+		for ks.index < len(ks.times) && CompareTimestamps(f.Pts(), ks.times[ks.index], ks.timeBase, TimeBaseQ) != CompareTimestampsResultABeforeB {
+			ks.index++
+			forced = true
+		}
+		return forced
+	}
+	return false
+}
@@ -0,0 +1,12 @@
+#include <libavutil/eval.h>
+
+enum {
+	ASTIAV_KEYFRAME_SCHEDULER_N,
+	ASTIAV_KEYFRAME_SCHEDULER_N_FORCED,
+	ASTIAV_KEYFRAME_SCHEDULER_PREV_FORCED_N,
+	ASTIAV_KEYFRAME_SCHEDULER_PREV_FORCED_T,
+	ASTIAV_KEYFRAME_SCHEDULER_T,
+	ASTIAV_KEYFRAME_SCHEDULER_NB,
+};
+
+int astiavKeyframeSchedulerParseExpr(AVExpr **expr, const char *s);
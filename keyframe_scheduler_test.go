@@ -0,0 +1,56 @@
+package astiav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyframeScheduler(t *testing.T) {
+	_, err := NewKeyframeScheduler("expr:invalid(", NewRational(1, 10))
+	require.Error(t, err)
+	_, err = NewKeyframeScheduler("invalid", NewRational(1, 10))
+	require.Error(t, err)
+
+	f := AllocFrame()
+	require.NotNil(t, f)
+	defer f.Free()
+
+	forced := func(ks *KeyframeScheduler, pts ...int64) (ns []int64) {
+		for _, pt := range pts {
+			f.SetPts(pt)
+			if ks.Apply(f) {
+				require.True(t, f.Flags().Has(FrameFlagKey))
+				require.Equal(t, PictureTypeI, f.PictureType())
+				ns = append(ns, pt)
+			} else {
+				require.False(t, f.Flags().Has(FrameFlagKey))
+				require.Equal(t, PictureTypeNone, f.PictureType())
+			}
+		}
+		return
+	}
+
+	ks1, err := NewKeyframeScheduler("expr:gte(t,n_forced*2)", NewRational(1, 10))
+	require.NoError(t, err)
+	defer ks1.Free()
+	require.Equal(t, []int64{0, 20, 40}, forced(ks1, 0, 5, 10, 15, 20, 25, 30, 35, 40, 45))
+
+	ks2, err := NewKeyframeScheduler("expr:eq(n,prev_forced_n+3)+eq(n,0)", NewRational(1, 10))
+	require.NoError(t, err)
+	defer ks2.Free()
+	require.Equal(t, []int64{0, 3, 6}, forced(ks2, 0, 1, 2, 3, 4, 5, 6, 7))
+
+	ks3, err := NewKeyframeScheduler("2.5, 0,00:00:01", NewRational(1, 10))
+	require.NoError(t, err)
+	defer ks3.Free()
+	require.Equal(t, []int64{0, 12, 25}, forced(ks3, 0, 5, 12, 13, 25, 30))
+
+	ks4, err := NewKeyframeScheduler("source", NewRational(1, 10))
+	require.NoError(t, err)
+	defer ks4.Free()
+	f.SetFlags(NewFrameFlags(FrameFlagKey))
+	require.True(t, ks4.Apply(f))
+	f.SetFlags(NewFrameFlags())
+	require.False(t, ks4.Apply(f))
+}
@@ -24,6 +24,11 @@ func (o *Option) Name() string {
 	return C.GoString(o.c.name)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVOption.html#ae6b670af4b2c7819c35437cab61c2745
+func (o *Option) Flags() OptionFlags {
+	return OptionFlags(o.c.flags)
+}
+
 type Options struct {
 	c unsafe.Pointer
 }
@@ -48,6 +53,13 @@ func (os *Options) List() (list []*Option) {
 	}
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__opt__mng.html#gae31ae7fb20113b00108d0ecf53f25664
+func (os *Options) Find(name string, f OptionSearchFlags) *Option {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return newOptionFromC(C.av_opt_find(os.c, cname, nil, 0, C.int(f)))
+}
+
 // https://www.ffmpeg.org/doxygen/7.0/group__opt__set__funcs.html#ga5fd4b92bdf4f392a2847f711676a7537
 func (os *Options) Set(name, value string, f OptionSearchFlags) error {
 	cname := C.CString(name)
@@ -0,0 +1,22 @@
+package astiav
+
+//#include <libavutil/opt.h>
+import "C"
+
+// https://ffmpeg.org/doxygen/8.0/group__avoptions.html
+type OptionFlag int64
+
+const (
+	OptionFlagAudioParam     = OptionFlag(C.AV_OPT_FLAG_AUDIO_PARAM)
+	OptionFlagBsfParam       = OptionFlag(C.AV_OPT_FLAG_BSF_PARAM)
+	OptionFlagChildConsts    = OptionFlag(C.AV_OPT_FLAG_CHILD_CONSTS)
+	OptionFlagDecodingParam  = OptionFlag(C.AV_OPT_FLAG_DECODING_PARAM)
+	OptionFlagDeprecated     = OptionFlag(C.AV_OPT_FLAG_DEPRECATED)
+	OptionFlagEncodingParam  = OptionFlag(C.AV_OPT_FLAG_ENCODING_PARAM)
+	OptionFlagExport         = OptionFlag(C.AV_OPT_FLAG_EXPORT)
+	OptionFlagFilteringParam = OptionFlag(C.AV_OPT_FLAG_FILTERING_PARAM)
+	OptionFlagReadonly       = OptionFlag(C.AV_OPT_FLAG_READONLY)
+	OptionFlagRuntimeParam   = OptionFlag(C.AV_OPT_FLAG_RUNTIME_PARAM)
+	OptionFlagSubtitleParam  = OptionFlag(C.AV_OPT_FLAG_SUBTITLE_PARAM)
+	OptionFlagVideoParam     = OptionFlag(C.AV_OPT_FLAG_VIDEO_PARAM)
+)
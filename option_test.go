@@ -18,6 +18,11 @@ func TestOption(t *testing.T) {
 	const name = "brand"
 	o := l[0]
 	require.Equal(t, name, o.Name())
+	require.True(t, o.Flags().Has(OptionFlagEncodingParam))
+	require.Nil(t, os.Find("invalid", NewOptionSearchFlags()))
+	o = os.Find(name, NewOptionSearchFlags())
+	require.NotNil(t, o)
+	require.Equal(t, name, o.Name())
 	_, err = os.Get("invalid", NewOptionSearchFlags())
 	require.Error(t, err)
 	v, err := os.Get(name, NewOptionSearchFlags())
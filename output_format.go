@@ -41,3 +41,90 @@ func (f *OutputFormat) LongName() string {
 func (f *OutputFormat) String() string {
 	return f.Name()
 }
+
+// https://ffmpeg.org/doxygen/8.0/structAVOutputFormat.html#a10f19abe463890063659723c90c15335
+func (f *OutputFormat) Extensions() string {
+	return C.GoString(f.c.extensions)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVOutputFormat.html#ad94e0c8bd362cb9ffbfc44514481fc75
+func (f *OutputFormat) MimeType() string {
+	return C.GoString(f.c.mime_type)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVOutputFormat.html#a2e4fff0aa061984d586ea08ecad96141
+func (f *OutputFormat) AudioCodec() CodecID {
+	return CodecID(f.c.audio_codec)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVOutputFormat.html#a1354a9c8542b1b698157218336bd4754
+func (f *OutputFormat) VideoCodec() CodecID {
+	return CodecID(f.c.video_codec)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVOutputFormat.html#adc8b4d7a5f6610e1816dd522e362217c
+func (f *OutputFormat) SubtitleCodec() CodecID {
+	return CodecID(f.c.subtitle_codec)
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__riff__fourcc.html#gabe1a7b6824078229c69b75c71053c035
+func (f *OutputFormat) CodecTag(id CodecID) (CodecTag, bool) {
+	if f.c.codec_tag == nil {
+		return 0, false
+	}
+	var t C.uint
+	if C.av_codec_get_tag2(f.c.codec_tag, C.enum_AVCodecID(id), &t) == 0 {
+		return 0, false
+	}
+	return CodecTag(t), true
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__misc.html#gaa90b4c72d1bbb298e11096d3a09ec7db
+func (f *OutputFormat) QueryCodec(id CodecID, c StrictStdCompliance) (bool, error) {
+	ret := C.avformat_query_codec(f.c, C.enum_AVCodecID(id), C.int(c))
+	if err := newError(ret); err != nil {
+		return false, err
+	}
+	return ret > 0, nil
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__encoding.html#ga074f2d4c5b6389216b48d4a5cb5aa452
+func (f *OutputFormat) GuessCodec(filename string, t MediaType) CodecID {
+	var cfilename *C.char
+	if filename != "" {
+		cfilename = C.CString(filename)
+		defer C.free(unsafe.Pointer(cfilename))
+	}
+	return CodecID(C.av_guess_codec(f.c, nil, cfilename, nil, C.enum_AVMediaType(t)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__encoding.html#ga00bceb049f2b20716e2f36ebc990a350
+func GuessOutputFormat(name, filename, mimeType string) *OutputFormat {
+	var cname, cfilename, cmimeType *C.char
+	if name != "" {
+		cname = C.CString(name)
+		defer C.free(unsafe.Pointer(cname))
+	}
+	if filename != "" {
+		cfilename = C.CString(filename)
+		defer C.free(unsafe.Pointer(cfilename))
+	}
+	if mimeType != "" {
+		cmimeType = C.CString(mimeType)
+		defer C.free(unsafe.Pointer(cmimeType))
+	}
+	return newOutputFormatFromC(C.av_guess_format(cname, cfilename, cmimeType))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__core.html#ga1a0f4b562917ad7d627f252ad64cc952
+func OutputFormats() (fs []*OutputFormat) {
+	var opq *C.void = nil
+	for {
+		f := C.av_muxer_iterate((*unsafe.Pointer)(unsafe.Pointer(&opq)))
+		if f == nil {
+			break
+		}
+		fs = append(fs, newOutputFormatFromC(f))
+	}
+	return
+}
@@ -13,4 +13,35 @@ func TestOutputFormat(t *testing.T) {
 	require.Equal(t, formatName, outputFormat.Name())
 	require.Equal(t, formatName, outputFormat.String())
 	require.Equal(t, "raw video", outputFormat.LongName())
+
+	mp4 := GuessOutputFormat("", "file.mp4", "")
+	require.NotNil(t, mp4)
+	require.Equal(t, "mp4", mp4.Name())
+	require.Equal(t, "mp4", mp4.Extensions())
+	require.Equal(t, "video/mp4", mp4.MimeType())
+	require.Equal(t, CodecIDAac, mp4.AudioCodec())
+	require.Equal(t, CodecIDAac, mp4.GuessCodec("", MediaTypeAudio))
+	ok, err := mp4.QueryCodec(CodecIDH264, StrictStdComplianceNormal)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	wav := FindOutputFormat("wav")
+	require.NotNil(t, wav)
+	require.Equal(t, CodecIDPcmS16Le, wav.AudioCodec())
+	require.Equal(t, CodecIDNone, wav.VideoCodec())
+	require.Equal(t, CodecIDNone, wav.SubtitleCodec())
+	tag, ok := wav.CodecTag(CodecIDPcmS16Le)
+	require.True(t, ok)
+	require.Equal(t, CodecTag(1), tag)
+	_, ok = wav.CodecTag(CodecIDH264)
+	require.False(t, ok)
+	ok, err = wav.QueryCodec(CodecIDH264, StrictStdComplianceNormal)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	var names []string
+	for _, f := range OutputFormats() {
+		names = append(names, f.Name())
+	}
+	require.Contains(t, names, "mp4")
 }
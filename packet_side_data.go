@@ -21,6 +21,35 @@ func newPacketSideDataFromC(sd **C.AVPacketSideData, size *C.int) *PacketSideDat
 	}
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__lavc__packet__side__data.html
+func (d *PacketSideData) CPBProperties() *packetSideDataCPBProperties {
+	return newPacketSideDataCPBProperties(d)
+}
+
+type packetSideDataCPBProperties struct {
+	d *PacketSideData
+}
+
+func newPacketSideDataCPBProperties(d *PacketSideData) *packetSideDataCPBProperties {
+	return &packetSideDataCPBProperties{d: d}
+}
+
+func (d *packetSideDataCPBProperties) Add(p *CPBProperties) error {
+	return d.d.addBytes(C.AV_PKT_DATA_CPB_PROPERTIES, p.bytes())
+}
+
+func (d *packetSideDataCPBProperties) Get() (*CPBProperties, bool) {
+	b := d.d.getBytes(C.AV_PKT_DATA_CPB_PROPERTIES)
+	if len(b) == 0 {
+		return nil, false
+	}
+	p, err := newCPBPropertiesFromBytes(b)
+	if err != nil {
+		return nil, false
+	}
+	return p, true
+}
+
 // https://ffmpeg.org/doxygen/8.0/group__lavc__packet__side__data.html#gga9a80bfcacc586b483a973272800edb97aab8c149a1e6c67aad340733becec87e1
 func (d *PacketSideData) DisplayMatrix() *packetSideDataDisplayMatrix {
 	return newPacketSideDataDisplayMatrix(d)
@@ -50,6 +79,35 @@ func (d *packetSideDataDisplayMatrix) Get() (*DisplayMatrix, bool) {
 	return m, true
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__lavc__packet__side__data.html
+func (d *PacketSideData) QualityStats() *packetSideDataQualityStats {
+	return newPacketSideDataQualityStats(d)
+}
+
+type packetSideDataQualityStats struct {
+	d *PacketSideData
+}
+
+func newPacketSideDataQualityStats(d *PacketSideData) *packetSideDataQualityStats {
+	return &packetSideDataQualityStats{d: d}
+}
+
+func (d *packetSideDataQualityStats) Add(qs *QualityStats) error {
+	return d.d.addBytes(C.AV_PKT_DATA_QUALITY_STATS, qs.bytes())
+}
+
+func (d *packetSideDataQualityStats) Get() (*QualityStats, bool) {
+	b := d.d.getBytes(C.AV_PKT_DATA_QUALITY_STATS)
+	if len(b) == 0 {
+		return nil, false
+	}
+	qs, err := newQualityStatsFromBytes(b)
+	if err != nil {
+		return nil, false
+	}
+	return qs, true
+}
+
 // https://ffmpeg.org/doxygen/8.0/group__lavc__packet__side__data.html#gga9a80bfcacc586b483a973272800edb97a2093332d8086d25a04942ede61007f6a
 func (d *PacketSideData) SkipSamples() *packetSideDataSkipSamples {
 	return newPacketSideDataSkipSamples(d)
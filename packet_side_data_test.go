@@ -41,4 +41,32 @@ func TestPacketSideData(t *testing.T) {
 	ss2, ok := sd.SkipSamples().Get()
 	require.True(t, ok)
 	require.Equal(t, ss1, ss2)
+
+	p1, ok := sd.CPBProperties().Get()
+	require.False(t, ok)
+	require.Nil(t, p1)
+	p1 = &CPBProperties{
+		AvgBitrate: 1,
+		BufferSize: 2,
+		MaxBitrate: 3,
+		MinBitrate: 4,
+		VbvDelay:   5,
+	}
+	require.NoError(t, sd.CPBProperties().Add(p1))
+	p2, ok := sd.CPBProperties().Get()
+	require.True(t, ok)
+	require.Equal(t, p1, p2)
+
+	qs1, ok := sd.QualityStats().Get()
+	require.False(t, ok)
+	require.Nil(t, qs1)
+	qs1 = &QualityStats{
+		Errors:      []uint64{1, 2, 3},
+		PictureType: PictureTypeI,
+		Quality:     4,
+	}
+	require.NoError(t, sd.QualityStats().Add(qs1))
+	qs2, ok := sd.QualityStats().Get()
+	require.True(t, ok)
+	require.Equal(t, qs1, qs2)
 }
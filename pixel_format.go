@@ -221,3 +221,56 @@ func FindPixelFormatByName(name string) PixelFormat {
 	defer C.free(unsafe.Pointer(cn))
 	return PixelFormat(C.av_get_pix_fmt(cn))
 }
+
+// https://ffmpeg.org/doxygen/8.0/pixdesc_8c.html#ac2e098ff3b5373af99be8ea954c4f72e
+func (f PixelFormat) SwapEndianness() PixelFormat {
+	return PixelFormat(C.av_pix_fmt_swap_endianness((C.enum_AVPixelFormat)(f)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/pixdesc_8c.html#a69ba6ef97543b19a15236a513e56dffe
+func PixelFormatLoss(dst, src PixelFormat, hasAlpha bool) PixelFormatLossFlags {
+	a := C.int(0)
+	if hasAlpha {
+		a = C.int(1)
+	}
+	return PixelFormatLossFlags(C.av_get_pix_fmt_loss((C.enum_AVPixelFormat)(dst), (C.enum_AVPixelFormat)(src), a))
+}
+
+// https://ffmpeg.org/doxygen/8.0/pixdesc_8c.html#a875f1e7a15d882d4cd9d38dde093939b
+func FindBestPixelFormatOf2(dst1, dst2, src PixelFormat, hasAlpha bool) (PixelFormat, PixelFormatLossFlags) {
+	a := C.int(0)
+	if hasAlpha {
+		a = C.int(1)
+	}
+	var loss C.int
+	p := PixelFormat(C.av_find_best_pix_fmt_of_2((C.enum_AVPixelFormat)(dst1), (C.enum_AVPixelFormat)(dst2), (C.enum_AVPixelFormat)(src), a, &loss))
+	return p, PixelFormatLossFlags(loss)
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavc__misc__pixfmt.html#ga9e74b43a3433ccfe836814f0a6371aa0
+func FindBestPixelFormatOfList(pfs []PixelFormat, src PixelFormat, hasAlpha bool) (PixelFormat, PixelFormatLossFlags) {
+	cpfs := make([]C.enum_AVPixelFormat, 0, len(pfs)+1)
+	for _, pf := range pfs {
+		cpfs = append(cpfs, (C.enum_AVPixelFormat)(pf))
+	}
+	cpfs = append(cpfs, C.AV_PIX_FMT_NONE)
+	a := C.int(0)
+	if hasAlpha {
+		a = C.int(1)
+	}
+	var loss C.int
+	p := PixelFormat(C.avcodec_find_best_pix_fmt_of_list(&cpfs[0], (C.enum_AVPixelFormat)(src), a, &loss))
+	return p, PixelFormatLossFlags(loss)
+}
+
+// https://ffmpeg.org/doxygen/8.0/pixdesc_8c.html#a110de37b82f3a2602d5c6a3e4babc7a8
+func PixelFormats() (pfs []PixelFormat) {
+	var d *C.AVPixFmtDescriptor
+	for {
+		if d = C.av_pix_fmt_desc_next(d); d == nil {
+			break
+		}
+		pfs = append(pfs, PixelFormat(C.av_pix_fmt_desc_get_id(d)))
+	}
+	return
+}
@@ -24,3 +24,78 @@ func (pfd *PixelFormatDescriptor) Name() string {
 func (pfd *PixelFormatDescriptor) Flags() PixelFormatDescriptorFlags {
 	return PixelFormatDescriptorFlags(pfd.c.flags)
 }
+
+// https://ffmpeg.org/doxygen/8.0/structAVPixFmtDescriptor.html#a85163c36645d2f372a4e98b314fa964b
+func (pfd *PixelFormatDescriptor) Alias() string {
+	if pfd.c.alias == nil {
+		return ""
+	}
+	return C.GoString(pfd.c.alias)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVPixFmtDescriptor.html#ae83de203f97288c9f4070212a5eac5de
+func (pfd *PixelFormatDescriptor) NbComponents() int {
+	return int(pfd.c.nb_components)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVPixFmtDescriptor.html#a4abca4534188ff94627e88c0d8362058
+func (pfd *PixelFormatDescriptor) Log2ChromaW() int {
+	return int(pfd.c.log2_chroma_w)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVPixFmtDescriptor.html#abea8ed308fe00644fc066a00018afa95
+func (pfd *PixelFormatDescriptor) Log2ChromaH() int {
+	return int(pfd.c.log2_chroma_h)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVPixFmtDescriptor.html#a7b9a29711120c04b1ec43ade9b83449d
+func (pfd *PixelFormatDescriptor) Components() (cs []PixelFormatComponentDescriptor) {
+	for i := 0; i < int(pfd.c.nb_components); i++ {
+		cs = append(cs, newPixelFormatComponentDescriptorFromC(pfd.c.comp[i]))
+	}
+	return
+}
+
+// https://ffmpeg.org/doxygen/8.0/pixdesc_8c.html#a7b24a65f6bf07585396da3bd301799b2
+func (pfd *PixelFormatDescriptor) BitsPerPixel() int {
+	return int(C.av_get_bits_per_pixel(pfd.c))
+}
+
+// https://ffmpeg.org/doxygen/8.0/pixdesc_8c.html#a89cf35c5ef18c9e996c7784e1a893b9e
+func (pfd *PixelFormatDescriptor) PaddedBitsPerPixel() int {
+	return int(C.av_get_padded_bits_per_pixel(pfd.c))
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVComponentDescriptor.html
+type PixelFormatComponentDescriptor struct {
+	c C.AVComponentDescriptor
+}
+
+func newPixelFormatComponentDescriptorFromC(c C.AVComponentDescriptor) PixelFormatComponentDescriptor {
+	return PixelFormatComponentDescriptor{c: c}
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVComponentDescriptor.html#a3508603e4efd7a1fcecab1da7b9ae4c2
+func (pfcd PixelFormatComponentDescriptor) Plane() int {
+	return int(pfcd.c.plane)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVComponentDescriptor.html#a964c2627fc25fef179ea006c88becf5b
+func (pfcd PixelFormatComponentDescriptor) Step() int {
+	return int(pfcd.c.step)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVComponentDescriptor.html#a1ad69ad2ebfc3045bf56fd8a98aa4439
+func (pfcd PixelFormatComponentDescriptor) Offset() int {
+	return int(pfcd.c.offset)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVComponentDescriptor.html#a10c6ab5726f0f666cbe801b5308960aa
+func (pfcd PixelFormatComponentDescriptor) Shift() int {
+	return int(pfcd.c.shift)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVComponentDescriptor.html#ae504ab46ea3719e9930f91491af593dc
+func (pfcd PixelFormatComponentDescriptor) Depth() int {
+	return int(pfcd.c.depth)
+}
@@ -12,4 +12,26 @@ func TestPixelFormatDescriptor(t *testing.T) {
 	require.NotNil(t, d)
 	require.Equal(t, d.Name(), p.String())
 	require.True(t, d.Flags().Has(PixelFormatDescriptorFlagHwAccel))
+
+	d = PixelFormatYuv420P.Descriptor()
+	require.NotNil(t, d)
+	require.Equal(t, "", d.Alias())
+	require.Equal(t, 3, d.NbComponents())
+	require.Equal(t, 1, d.Log2ChromaW())
+	require.Equal(t, 1, d.Log2ChromaH())
+	cs := d.Components()
+	require.Len(t, cs, 3)
+	for i, c := range cs {
+		require.Equal(t, i, c.Plane())
+		require.Equal(t, 1, c.Step())
+		require.Equal(t, 0, c.Offset())
+		require.Equal(t, 0, c.Shift())
+		require.Equal(t, 8, c.Depth())
+	}
+	require.Equal(t, 12, d.BitsPerPixel())
+	require.Equal(t, 12, d.PaddedBitsPerPixel())
+
+	d = PixelFormatGray8.Descriptor()
+	require.NotNil(t, d)
+	require.Equal(t, "gray8,y800", d.Alias())
 }
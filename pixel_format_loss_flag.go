@@ -0,0 +1,18 @@
+package astiav
+
+//#include <libavutil/pixdesc.h>
+import "C"
+
+// https://ffmpeg.org/doxygen/8.0/pixdesc_8h.html
+type PixelFormatLossFlag int64
+
+const (
+	PixelFormatLossFlagAlpha            = PixelFormatLossFlag(C.FF_LOSS_ALPHA)
+	PixelFormatLossFlagChroma           = PixelFormatLossFlag(C.FF_LOSS_CHROMA)
+	PixelFormatLossFlagColorquant       = PixelFormatLossFlag(C.FF_LOSS_COLORQUANT)
+	PixelFormatLossFlagColorspace       = PixelFormatLossFlag(C.FF_LOSS_COLORSPACE)
+	PixelFormatLossFlagDepth            = PixelFormatLossFlag(C.FF_LOSS_DEPTH)
+	PixelFormatLossFlagExcessDepth      = PixelFormatLossFlag(C.FF_LOSS_EXCESS_DEPTH)
+	PixelFormatLossFlagExcessResolution = PixelFormatLossFlag(C.FF_LOSS_EXCESS_RESOLUTION)
+	PixelFormatLossFlagResolution       = PixelFormatLossFlag(C.FF_LOSS_RESOLUTION)
+)
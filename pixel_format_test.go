@@ -13,4 +13,23 @@ func TestPixelFormat(t *testing.T) {
 	d := p.Descriptor()
 	require.NotNil(t, d)
 	require.Equal(t, d.Name(), p.String())
+
+	require.Equal(t, PixelFormatRgb48Le, PixelFormatRgb48Be.SwapEndianness())
+	require.Equal(t, PixelFormatNone, PixelFormatYuv420P.SwapEndianness())
+
+	require.True(t, PixelFormatLoss(PixelFormatGray8, PixelFormatYuv420P, false).Has(PixelFormatLossFlagChroma))
+	p, l := FindBestPixelFormatOf2(PixelFormatRgb24, PixelFormatYuv420P, PixelFormatYuv420P, false)
+	require.Equal(t, PixelFormatYuv420P, p)
+	require.Equal(t, PixelFormatLossFlags(0), l)
+
+	p, l = FindBestPixelFormatOfList([]PixelFormat{PixelFormatGray8, PixelFormatYuv444P}, PixelFormatYuv420P, false)
+	require.Equal(t, PixelFormatYuv444P, p)
+	require.False(t, l.Has(PixelFormatLossFlagChroma))
+	p, _ = FindBestPixelFormatOfList(nil, PixelFormatYuv420P, false)
+	require.Equal(t, PixelFormatNone, p)
+
+	pfs := PixelFormats()
+	require.Contains(t, pfs, PixelFormatYuv420P)
+	require.Contains(t, pfs, PixelFormatCuda)
+	require.NotContains(t, pfs, PixelFormatNone)
 }
@@ -0,0 +1,43 @@
+package astiav
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// https://ffmpeg.org/doxygen/8.0/group__lavc__packet__side__data.html
+type QualityStats struct {
+	// Sum of squared differences between the encoder input and the reconstructed plane, one per plane.
+	// Only filled when CodecContextFlagPsnr is set
+	Errors      []uint64
+	PictureType PictureType
+	// Quality factor of the compressed frame, in lambda units (QP * FF_QP2LAMBDA)
+	Quality uint32
+}
+
+func newQualityStatsFromBytes(b []byte) (*QualityStats, error) {
+	if len(b) < 8 {
+		return nil, fmt.Errorf("astiav: invalid length %d < 8", len(b))
+	}
+	n := int(b[5])
+	if len(b) < 8+8*n {
+		return nil, fmt.Errorf("astiav: invalid length %d < %d", len(b), 8+8*n)
+	}
+	qs := &QualityStats{
+		PictureType: PictureType(b[4]),
+		Quality:     binary.LittleEndian.Uint32(b[0:4]),
+	}
+	for i := 0; i < n; i++ {
+		qs.Errors = append(qs.Errors, binary.LittleEndian.Uint64(b[8+8*i:16+8*i]))
+	}
+	return qs, nil
+}
+
+func (qs *QualityStats) bytes() (b []byte) {
+	b = binary.LittleEndian.AppendUint32(b, qs.Quality)
+	b = append(b, uint8(qs.PictureType), uint8(len(qs.Errors)), 0, 0)
+	for _, e := range qs.Errors {
+		b = binary.LittleEndian.AppendUint64(b, e)
+	}
+	return b
+}
@@ -0,0 +1,24 @@
+package astiav
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQualityStats(t *testing.T) {
+	_, err := newQualityStatsFromBytes([]byte("1234567"))
+	require.Error(t, err)
+	_, err = newQualityStatsFromBytes([]byte{0x1, 0x0, 0x0, 0x0, 0x1, 0x1, 0x0, 0x0})
+	require.Error(t, err)
+	qs1 := &QualityStats{
+		Errors:      []uint64{1, 2},
+		PictureType: PictureTypeP,
+		Quality:     3,
+	}
+	b1 := qs1.bytes()
+	require.Equal(t, []byte{0x3, 0x0, 0x0, 0x0, 0x2, 0x2, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, b1)
+	qs2, err := newQualityStatsFromBytes(b1)
+	require.NoError(t, err)
+	require.Equal(t, qs1, qs2)
+}
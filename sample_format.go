@@ -1,7 +1,9 @@
 package astiav
 
 //#include <libavutil/samplefmt.h>
+//#include <stdlib.h>
 import "C"
+import "unsafe"
 
 // https://ffmpeg.org/doxygen/8.0/group__lavu__sampfmts.html#gaf9a51ca15301871723577c730b5865c5
 type SampleFormat C.enum_AVSampleFormat
@@ -41,3 +43,33 @@ func (f SampleFormat) BytesPerSample() int {
 func (f SampleFormat) IsPlanar() bool {
 	return C.av_sample_fmt_is_planar((C.enum_AVSampleFormat)(f)) > 0
 }
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__sampfmts.html#ga7817ec0eff4dc6fc0962f31e6d138bca
+func (f SampleFormat) PackedSampleFormat() SampleFormat {
+	return SampleFormat(C.av_get_packed_sample_fmt((C.enum_AVSampleFormat)(f)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__sampfmts.html#ga82caf838259d95cc6c4fd87633bb0e19
+func (f SampleFormat) PlanarSampleFormat() SampleFormat {
+	return SampleFormat(C.av_get_planar_sample_fmt((C.enum_AVSampleFormat)(f)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__sampfmts.html#ga655c989b749667468e5e839e26fe63db
+func FindSampleFormatByName(name string) SampleFormat {
+	cn := C.CString(name)
+	defer C.free(unsafe.Pointer(cn))
+	return SampleFormat(C.av_get_sample_fmt(cn))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavu__sampfmts.html#ga31b9d149b2de9821a65f4f5612970838
+//
+// Sample formats are listed until av_get_sample_fmt_name() returns NULL since AV_SAMPLE_FMT_NB must
+// not be used when linking dynamically
+func SampleFormats() (fs []SampleFormat) {
+	for i := 0; ; i++ {
+		if C.av_get_sample_fmt_name((C.enum_AVSampleFormat)(i)) == nil {
+			return
+		}
+		fs = append(fs, SampleFormat(i))
+	}
+}
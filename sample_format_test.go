@@ -11,4 +11,11 @@ func TestSampleFormat(t *testing.T) {
 	require.Equal(t, 2, SampleFormatS16.BytesPerSample())
 	require.False(t, SampleFormatS16.IsPlanar())
 	require.True(t, SampleFormatS16P.IsPlanar())
+	require.Equal(t, SampleFormatS16, SampleFormatS16P.PackedSampleFormat())
+	require.Equal(t, SampleFormatS16P, SampleFormatS16.PlanarSampleFormat())
+	require.Equal(t, SampleFormatFltp, FindSampleFormatByName("fltp"))
+	require.Equal(t, SampleFormatNone, FindSampleFormatByName("invalid"))
+	fs := SampleFormats()
+	require.Contains(t, fs, SampleFormatS16)
+	require.NotContains(t, fs, SampleFormatNone)
 }
@@ -196,3 +196,110 @@ func (ssc *SoftwareScaleContext) SetSourceResolution(w int, h int) error {
 		u.srcH = h
 	})
 }
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#gaf1596b10563e2851c46c4de92736188a
+func (ssc *SoftwareScaleContext) FrameStart(src, dst *Frame) error {
+	return newError(C.sws_frame_start(ssc.c, dst.c, src.c))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#gad48e9f18ad68427a0b0c564801de5d77
+func (ssc *SoftwareScaleContext) FrameEnd() {
+	C.sws_frame_end(ssc.c)
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#ga3394509b390ece6e39a027861a3fad4a
+func (ssc *SoftwareScaleContext) SendSlice(sliceStart, sliceHeight uint) error {
+	return newError(C.sws_send_slice(ssc.c, C.uint(sliceStart), C.uint(sliceHeight)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#ga19c354a10825af537aab99048b9eb900
+func (ssc *SoftwareScaleContext) ReceiveSlice(sliceStart, sliceHeight uint) error {
+	return newError(C.sws_receive_slice(ssc.c, C.uint(sliceStart), C.uint(sliceHeight)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#ga6df42b4622cf0ed9ac8d6f8bdf0b70db
+func (ssc *SoftwareScaleContext) ReceiveSliceAlignment() uint {
+	return uint(C.sws_receive_slice_alignment(ssc.c))
+}
+
+// Tables can be retrieved with SoftwareScaleCoefficients. Brightness, contrast and saturation are
+// 16.16 fixed point values (e.g. 1<<16 means 1.0)
+type SoftwareScaleContextColorspaceDetails struct {
+	Brightness           int
+	Contrast             int
+	DestinationFullRange bool
+	DestinationTable     [4]int
+	Saturation           int
+	SourceFullRange      bool
+	SourceInverseTable   [4]int
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#ga1f9aed5176a5f8f169b8254ac57bdba7
+func (ssc *SoftwareScaleContext) ColorspaceDetails() (d SoftwareScaleContextColorspaceDetails, err error) {
+	var invTable, table *C.int
+	var srcRange, dstRange, brightness, contrast, saturation C.int
+	if err = newError(C.sws_getColorspaceDetails(ssc.c, &invTable, &srcRange, &table, &dstRange, &brightness, &contrast, &saturation)); err != nil {
+		return
+	}
+	d.Brightness = int(brightness)
+	d.Contrast = int(contrast)
+	d.DestinationFullRange = dstRange > 0
+	d.Saturation = int(saturation)
+	d.SourceFullRange = srcRange > 0
+	for i, v := range unsafe.Slice(table, 4) {
+		d.DestinationTable[i] = int(v)
+	}
+	for i, v := range unsafe.Slice(invTable, 4) {
+		d.SourceInverseTable[i] = int(v)
+	}
+	return
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#gafe7b7ca136c8b6bd1fbfca2beb28c5b1
+func (ssc *SoftwareScaleContext) SetColorspaceDetails(d SoftwareScaleContextColorspaceDetails) error {
+	var invTable, table [4]C.int
+	for i := range invTable {
+		invTable[i] = C.int(d.SourceInverseTable[i])
+		table[i] = C.int(d.DestinationTable[i])
+	}
+	var srcRange, dstRange C.int
+	if d.SourceFullRange {
+		srcRange = 1
+	}
+	if d.DestinationFullRange {
+		dstRange = 1
+	}
+	return newError(C.sws_setColorspaceDetails(ssc.c, &invTable[0], srcRange, &table[0], dstRange, C.int(d.Brightness), C.int(d.Contrast), C.int(d.Saturation)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#gac271c2aa3dd5569c59f018eaf914bbc0
+func SoftwareScaleCoefficients(s ColorSpace) [4]int {
+	var o [4]int
+	for i, v := range unsafe.Slice(C.sws_getCoefficients(C.int(s)), 4) {
+		o[i] = int(v)
+	}
+	return o
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#gabf3c64eb307bbcd57495a00a1f8fe593
+func SoftwareScaleTestFormat(p PixelFormat, output bool) bool {
+	o := C.int(0)
+	if output {
+		o = C.int(1)
+	}
+	return C.sws_test_format(C.enum_AVPixelFormat(p), o) > 0
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#ga26061dc3409549af7c4f372ddf99101c
+func SoftwareScaleTestColorSpace(s ColorSpace, output bool) bool {
+	o := C.int(0)
+	if output {
+		o = C.int(1)
+	}
+	return C.sws_test_colorspace(C.enum_AVColorSpace(s), o) > 0
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__libsws.html#ga50bb392cac2bf4e626e309b56ab76c32
+func SoftwareScaleIsNoop(src, dst *Frame) bool {
+	return C.sws_is_noop(dst.c, src.c) > 0
+}
@@ -110,4 +110,47 @@ func TestSoftwareScaleContext(t *testing.T) {
 	b2, err := os.ReadFile("testdata/image-rgba-upscaled-bytes")
 	require.NoError(t, err)
 	require.Equal(t, b2, b1)
+
+	swsc3, err := CreateSoftwareScaleContext(4, 2, PixelFormatYuv420P, 8, 4, PixelFormatRgba, NewSoftwareScaleContextFlags(SoftwareScaleContextFlagBilinear))
+	require.NoError(t, err)
+	defer swsc3.Free()
+
+	require.Equal(t, [4]int{117489, 138438, 13975, 34925}, SoftwareScaleCoefficients(ColorSpaceBt709))
+	d1 := SoftwareScaleContextColorspaceDetails{
+		Brightness:         0,
+		Contrast:           1 << 16,
+		DestinationTable:   SoftwareScaleCoefficients(ColorSpaceBt709),
+		Saturation:         1 << 16,
+		SourceFullRange:    true,
+		SourceInverseTable: SoftwareScaleCoefficients(ColorSpaceBt709),
+	}
+	require.NoError(t, swsc3.SetColorspaceDetails(d1))
+	d2, err := swsc3.ColorspaceDetails()
+	require.NoError(t, err)
+	require.Equal(t, d1.Brightness, d2.Brightness)
+	require.Equal(t, d1.Contrast, d2.Contrast)
+	require.Equal(t, d1.Saturation, d2.Saturation)
+	require.True(t, d2.SourceFullRange)
+	require.Equal(t, d1.SourceInverseTable, d2.SourceInverseTable)
+
+	f6 := AllocFrame()
+	require.NotNil(t, f6)
+	defer f6.Free()
+	f6.SetHeight(4)
+	f6.SetPixelFormat(PixelFormatRgba)
+	f6.SetWidth(8)
+	require.NoError(t, f6.AllocBuffer(0))
+
+	require.NoError(t, swsc3.FrameStart(f1, f6))
+	require.NoError(t, swsc3.SendSlice(0, 2))
+	require.Equal(t, uint(1), swsc3.ReceiveSliceAlignment())
+	require.NoError(t, swsc3.ReceiveSlice(0, 4))
+	swsc3.FrameEnd()
+
+	require.True(t, SoftwareScaleTestFormat(PixelFormatYuv420P, false))
+	require.True(t, SoftwareScaleTestFormat(PixelFormatRgba, true))
+	require.False(t, SoftwareScaleTestFormat(PixelFormatVaapi, true))
+	require.True(t, SoftwareScaleTestColorSpace(ColorSpaceBt709, true))
+	require.True(t, SoftwareScaleIsNoop(f1, f1))
+	require.False(t, SoftwareScaleIsNoop(f1, f6))
 }
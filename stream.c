@@ -0,0 +1,11 @@
+#include <libavformat/avformat.h>
+
+int astiavIndexEntryFlags(const AVIndexEntry *e)
+{
+	return e->flags;
+}
+
+int astiavIndexEntrySize(const AVIndexEntry *e)
+{
+	return e->size;
+}
@@ -1,6 +1,7 @@
 package astiav
 
 //#include <libavformat/avformat.h>
+//#include "stream.h"
 import "C"
 import "unsafe"
 
@@ -26,6 +27,32 @@ func (s *Stream) SetAvgFrameRate(r Rational) {
 	s.c.avg_frame_rate = r.c
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVStream.html#a8c689ee00c0dfe4313891f8a2ea21f4d
+//
+// Returned packet is owned by the stream and must not be freed
+func (s *Stream) AttachedPicture() *Packet {
+	return newPacketFromC(&s.c.attached_pic)
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVStream.html#a8c689ee00c0dfe4313891f8a2ea21f4d
+//
+// Packet is referenced and the stream is flagged with DispositionFlagAttachedPic. When muxing, the packet
+// still needs to be written like any other packet.
+func (s *Stream) SetAttachedPicture(p *Packet) error {
+	C.av_packet_unref(&s.c.attached_pic)
+	if p == nil {
+		s.c.disposition &^= C.AV_DISPOSITION_ATTACHED_PIC
+		return nil
+	}
+	if err := newError(C.av_packet_ref(&s.c.attached_pic, p.c)); err != nil {
+		return err
+	}
+	s.c.attached_pic.stream_index = s.c.index
+	s.c.attached_pic.flags |= C.AV_PKT_FLAG_KEY
+	s.c.disposition |= C.AV_DISPOSITION_ATTACHED_PIC
+	return nil
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVStream.html#a4737d8b012827558f55a6f559b253496
 func (s *Stream) Class() *Class {
 	if s.c == nil {
@@ -39,6 +66,16 @@ func (s *Stream) CodecParameters() *CodecParameters {
 	return newCodecParametersFromC(s.c.codecpar)
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParameters.html#a9b6f7d220d100ba73defab295623356b
+func (s *Stream) CodecTag() CodecTag {
+	return s.CodecParameters().CodecTag()
+}
+
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParameters.html#a9b6f7d220d100ba73defab295623356b
+func (s *Stream) SetCodecTag(t CodecTag) {
+	s.CodecParameters().SetCodecTag(t)
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVStream.html#a492fcecc45dbbd8da51edd0124e9dd30
 func (s *Stream) Discard() Discard {
 	return Discard(s.c.discard)
@@ -94,6 +131,34 @@ func (s *Stream) SetIndex(i int) {
 	s.c.index = C.int(i)
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__lavf__decoding.html#ga2ee823d22f7e3d5387ac6a62ba16da09
+func (s *Stream) IndexEntriesCount() int {
+	return int(C.avformat_index_get_entries_count(s.c))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__decoding.html#ga29dabd64d563cd8ba6057f5320e17379
+func (s *Stream) IndexEntry(idx int) (IndexEntry, bool) {
+	return newIndexEntryFromC(C.avformat_index_get_entry(s.c, C.int(idx)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__decoding.html#gaaa076d86ff17b8b4f442d1b92b7317de
+func (s *Stream) IndexEntryFromTimestamp(timestamp int64, f SeekFlags) (IndexEntry, bool) {
+	return newIndexEntryFromC(C.avformat_index_get_entry_from_timestamp(s.c, C.int64_t(timestamp), C.int(f)))
+}
+
+// https://ffmpeg.org/doxygen/8.0/group__lavf__misc.html#ga3ed7441cb4af0bdd65a4cf51bf35088e
+func (s *Stream) AddIndexEntry(e IndexEntry) error {
+	var flags C.int
+	if e.Keyframe {
+		flags |= C.AVINDEX_KEYFRAME
+	}
+	ret := C.av_add_index_entry(s.c, C.int64_t(e.Pos), C.int64_t(e.Timestamp), C.int(e.Size), C.int(e.MinDistance), flags)
+	if ret < 0 {
+		return newError(ret)
+	}
+	return nil
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVStream.html#a50d250a128a3da9ce3d135e84213fb82
 func (s *Stream) Metadata() *Dictionary {
 	return newDictionaryFromC(s.c.metadata)
@@ -113,6 +178,13 @@ func (s *Stream) NbFrames() int64 {
 	return int64(s.c.nb_frames)
 }
 
+// https://ffmpeg.org/doxygen/8.0/group__lavf__misc.html#ga125e78a8250557501479986fead28a43
+//
+// Parser is only available while demuxing streams that need parsing
+func (s *Stream) Parser() *CodecParserContext {
+	return newCodecParserContextFromC(C.av_stream_get_parser(s.c))
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVStream.html#a6cdb0c90a69899f4e1e54704bb654936
 func (s *Stream) PTSWrapBits() int {
 	return int(s.c.pts_wrap_bits)
@@ -133,6 +205,11 @@ func (s *Stream) SetRFrameRate(r Rational) {
 	s.c.r_frame_rate = r.c
 }
 
+// https://ffmpeg.org/doxygen/8.0/structAVCodecParameters.html#ad54da9241deabb3601e6e0e8fa832c19
+func (s *Stream) SideData() *PacketSideData {
+	return s.CodecParameters().SideData()
+}
+
 // https://ffmpeg.org/doxygen/8.0/structAVStream.html#a3f19c60ac6da237cd10e4d97150c118e
 func (s *Stream) SampleAspectRatio() Rational {
 	return newRationalFromC(s.c.sample_aspect_ratio)
@@ -162,3 +239,25 @@ func (s *Stream) TimeBase() Rational {
 func (s *Stream) SetTimeBase(r Rational) {
 	s.c.time_base = r.c
 }
+
+// https://ffmpeg.org/doxygen/8.0/structAVIndexEntry.html
+type IndexEntry struct {
+	Keyframe    bool
+	MinDistance int
+	Pos         int64
+	Size        int
+	Timestamp   int64
+}
+
+func newIndexEntryFromC(c *C.AVIndexEntry) (IndexEntry, bool) {
+	if c == nil {
+		return IndexEntry{}, false
+	}
+	return IndexEntry{
+		Keyframe:    C.astiavIndexEntryFlags(c)&C.AVINDEX_KEYFRAME > 0,
+		MinDistance: int(c.min_distance),
+		Pos:         int64(c.pos),
+		Size:        int(C.astiavIndexEntrySize(c)),
+		Timestamp:   int64(c.timestamp),
+	}, true
+}
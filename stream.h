@@ -0,0 +1,4 @@
+#include <libavformat/avformat.h>
+
+int astiavIndexEntryFlags(const AVIndexEntry *e);
+int astiavIndexEntrySize(const AVIndexEntry *e);
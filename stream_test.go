@@ -26,6 +26,18 @@ func TestStream(t *testing.T) {
 	require.Equal(t, NewRational(1, 1), s1.SampleAspectRatio())
 	require.Equal(t, int64(0), s1.StartTime())
 	require.Equal(t, NewRational(1, 12288), s1.TimeBase())
+	require.Equal(t, 120, s1.IndexEntriesCount())
+	ie, ok := s1.IndexEntry(0)
+	require.True(t, ok)
+	require.True(t, ie.Keyframe)
+	require.Greater(t, ie.Size, 0)
+	_, ok = s1.IndexEntry(120)
+	require.False(t, ok)
+	ie, ok = s1.IndexEntryFromTimestamp(s1.Duration(), NewSeekFlags(SeekFlagBackward))
+	require.True(t, ok)
+	require.True(t, ie.Keyframe)
+	require.Equal(t, s1.CodecParameters().CodecTag(), s1.CodecTag())
+	require.Nil(t, s1.AttachedPicture().Data())
 	cl := s1.Class()
 	require.NotNil(t, cl)
 	require.Equal(t, "AVStream", cl.Name())
@@ -68,4 +80,52 @@ func TestStream(t *testing.T) {
 	require.Equal(t, "v", e.Value())
 	s1.SetMetadata(nil)
 	require.Nil(t, s1.Metadata())
+
+	fc2, err := AllocOutputFormatContext(nil, "mp4", "")
+	require.NoError(t, err)
+	defer fc2.Free()
+	s3 := fc2.NewStream(nil)
+	require.NotNil(t, s3)
+	require.Equal(t, 0, s3.IndexEntriesCount())
+	require.NoError(t, s3.AddIndexEntry(IndexEntry{Keyframe: true, Pos: 10, Size: 20, Timestamp: 0}))
+	require.NoError(t, s3.AddIndexEntry(IndexEntry{Pos: 30, Size: 40, Timestamp: 10}))
+	require.Equal(t, 2, s3.IndexEntriesCount())
+	ie, ok = s3.IndexEntry(1)
+	require.True(t, ok)
+	require.Equal(t, IndexEntry{Pos: 30, Size: 40, Timestamp: 10}, ie)
+	ie, ok = s3.IndexEntryFromTimestamp(10, NewSeekFlags(SeekFlagBackward))
+	require.True(t, ok)
+	require.Equal(t, IndexEntry{Keyframe: true, Pos: 10, Size: 20, Timestamp: 0}, ie)
+	require.Error(t, s3.AddIndexEntry(IndexEntry{Timestamp: NoPtsValue}))
+
+	pkt := AllocPacket()
+	require.NotNil(t, pkt)
+	defer pkt.Free()
+	require.NoError(t, pkt.FromData([]byte("picture")))
+	require.NoError(t, s3.SetAttachedPicture(pkt))
+	require.True(t, s3.DispositionFlags().Has(DispositionFlagAttachedPic))
+	require.Equal(t, []byte("picture"), s3.AttachedPicture().Data())
+	require.True(t, s3.AttachedPicture().Flags().Has(PacketFlagKey))
+	require.NoError(t, s3.SetAttachedPicture(nil))
+	require.False(t, s3.DispositionFlags().Has(DispositionFlagAttachedPic))
+	require.Nil(t, s3.AttachedPicture().Data())
+	s3.SetCodecTag(CodecTag(1))
+	require.Equal(t, CodecTag(1), s3.CodecTag())
+	require.Equal(t, CodecTag(1), s3.CodecParameters().CodecTag())
+	require.NotNil(t, s3.SideData())
+
+	fc3 := AllocFormatContext()
+	require.NotNil(t, fc3)
+	defer fc3.Free()
+	require.NoError(t, fc3.OpenInput("testdata/video-yuv420p.h264", nil, nil))
+	defer fc3.CloseInput()
+	s4 := fc3.Streams()[0]
+	require.Nil(t, fc2.Streams()[0].Parser())
+	pkt2 := AllocPacket()
+	require.NotNil(t, pkt2)
+	defer pkt2.Free()
+	require.NoError(t, fc3.ReadFrame(pkt2))
+	p := s4.Parser()
+	require.NotNil(t, p)
+	require.Greater(t, p.Width(), 0)
 }
@@ -0,0 +1,148 @@
+package astiav
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TwoPassEncoderConfigureFunc configures the codec context before it is opened. It is called
+// once per pass, pass being either 1 or 2.
+type TwoPassEncoderConfigureFunc func(cc *CodecContext, pass int) error
+
+// TwoPassEncoderSourceFunc sends every frame to encode to sendFrame. It is called once per pass
+// and must send the same frames during both passes.
+type TwoPassEncoderSourceFunc func(pass int, sendFrame func(f *Frame) error) error
+
+// TwoPassEncoder runs a first encoding pass collecting statistics in memory and a second
+// encoding pass using those statistics
+type TwoPassEncoder struct {
+	c         *Codec
+	configure TwoPassEncoderConfigureFunc
+	stats     string
+}
+
+func NewTwoPassEncoder(c *Codec, configure TwoPassEncoderConfigureFunc) (*TwoPassEncoder, error) {
+	if c == nil {
+		return nil, errors.New("astiav: codec must not be nil")
+	}
+	if configure == nil {
+		return nil, errors.New("astiav: configure func must not be nil")
+	}
+	return &TwoPassEncoder{
+		c:         c,
+		configure: configure,
+	}, nil
+}
+
+// Stats returns the statistics collected during the first pass
+func (e *TwoPassEncoder) Stats() string {
+	return e.stats
+}
+
+// Encode runs both passes. Packets produced by the first pass are discarded whereas packets
+// produced by the second pass are sent to onPacket and unreferenced once it returns.
+func (e *TwoPassEncoder) Encode(source TwoPassEncoderSourceFunc, onPacket func(p *Packet) error) error {
+	// First pass
+	var b strings.Builder
+	if err := e.pass(1, source, &b, nil); err != nil {
+		return fmt.Errorf("astiav: first pass failed: %w", err)
+	}
+	e.stats = b.String()
+
+	// Second pass
+	if err := e.pass(2, source, nil, onPacket); err != nil {
+		return fmt.Errorf("astiav: second pass failed: %w", err)
+	}
+	return nil
+}
+
+func (e *TwoPassEncoder) pass(pass int, source TwoPassEncoderSourceFunc, stats *strings.Builder, onPacket func(p *Packet) error) error {
+	// Alloc codec context
+	cc := AllocCodecContext(e.c)
+	if cc == nil {
+		return errors.New("astiav: allocated codec context is nil")
+	}
+	defer cc.Free()
+
+	// Configure
+	if err := e.configure(cc, pass); err != nil {
+		return fmt.Errorf("astiav: configuring codec context failed: %w", err)
+	}
+	switch pass {
+	case 1:
+		cc.SetFlags(cc.Flags().Add(CodecContextFlagPass1))
+	default:
+		cc.SetFlags(cc.Flags().Add(CodecContextFlagPass2))
+		if err := cc.SetStatsIn(e.stats); err != nil {
+			return fmt.Errorf("astiav: setting stats in failed: %w", err)
+		}
+	}
+
+	// Open
+	if err := cc.Open(e.c, nil); err != nil {
+		return fmt.Errorf("astiav: opening codec context failed: %w", err)
+	}
+
+	// Alloc packet
+	p := AllocPacket()
+	defer p.Free()
+
+	// Create collect stats func
+	//
+	// Some encoders (e.g. libvpx) only fill stats out when flushed, therefore it must be read after
+	// each successful receive as well as on EOF, in which case stats that have already been
+	// collected must not be appended twice
+	var lastStats string
+	collectStats := func(eof bool) {
+		if stats == nil {
+			return
+		}
+		s := cc.StatsOut()
+		if s == "" || (eof && s == lastStats) {
+			return
+		}
+		stats.WriteString(s)
+		lastStats = s
+	}
+
+	// Create receive func
+	receive := func() error {
+		for {
+			if err := cc.ReceivePacket(p); err != nil {
+				if errors.Is(err, ErrEof) {
+					collectStats(true)
+					return nil
+				} else if errors.Is(err, ErrEagain) {
+					return nil
+				}
+				return fmt.Errorf("astiav: receiving packet failed: %w", err)
+			}
+			collectStats(false)
+			var err error
+			if onPacket != nil {
+				err = onPacket(p)
+			}
+			p.Unref()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Encode
+	if err := source(pass, func(f *Frame) error {
+		if err := cc.SendFrame(f); err != nil {
+			return fmt.Errorf("astiav: sending frame failed: %w", err)
+		}
+		return receive()
+	}); err != nil {
+		return err
+	}
+
+	// Flush
+	if err := cc.SendFrame(nil); err != nil {
+		return fmt.Errorf("astiav: flushing failed: %w", err)
+	}
+	return receive()
+}
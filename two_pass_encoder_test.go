@@ -0,0 +1,65 @@
+package astiav
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwoPassEncoder(t *testing.T) {
+	_, err := NewTwoPassEncoder(nil, func(cc *CodecContext, pass int) error { return nil })
+	require.Error(t, err)
+
+	c := FindEncoder(CodecIDMpeg4)
+	require.NotNil(t, c)
+
+	f := AllocFrame()
+	require.NotNil(t, f)
+	defer f.Free()
+	f.SetHeight(64)
+	f.SetPixelFormat(PixelFormatYuv420P)
+	f.SetWidth(64)
+	require.NoError(t, f.AllocBuffer(0))
+	require.NoError(t, f.ImageFillBlack())
+
+	var passes []int
+	e, err := NewTwoPassEncoder(c, func(cc *CodecContext, pass int) error {
+		passes = append(passes, pass)
+		cc.SetBitRate(100000)
+		cc.SetHeight(64)
+		cc.SetPixelFormat(PixelFormatYuv420P)
+		cc.SetTimeBase(NewRational(1, 25))
+		cc.SetWidth(64)
+		return nil
+	})
+	require.NoError(t, err)
+
+	var nbPackets int
+	require.NoError(t, e.Encode(func(pass int, sendFrame func(f *Frame) error) error {
+		for i := 0; i < 10; i++ {
+			f.SetPts(int64(i))
+			if err := sendFrame(f); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(p *Packet) error {
+		nbPackets++
+		require.Greater(t, p.Size(), 0)
+		return nil
+	}))
+	require.Equal(t, []int{1, 2}, passes)
+	require.Equal(t, 10, nbPackets)
+	require.Equal(t, 10, strings.Count(e.Stats(), "\n"))
+
+	cc := AllocCodecContext(c)
+	require.NotNil(t, cc)
+	defer cc.Free()
+	require.Equal(t, "", cc.StatsIn())
+	require.NoError(t, cc.SetStatsIn(e.Stats()))
+	require.Equal(t, e.Stats(), cc.StatsIn())
+	require.NoError(t, cc.SetStatsIn(""))
+	require.Equal(t, "", cc.StatsIn())
+	require.Equal(t, "", cc.StatsOut())
+}